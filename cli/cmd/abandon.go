@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+)
+
+// abandonCmd represents the abandon command
+var (
+	abandonTxid string
+
+	abandonCmd = &cobra.Command{
+		Use:   "abandon",
+		Short: "Gives up on an unconfirmed outgoing transaction",
+		Long: "The inputs of the transaction are set to unspent again so that they can be used for new transactions.\n" +
+			"The daemon stops broadcasting the transaction. If it is still in a mempool somewhere it might confirm nonetheless,\n" +
+			"prefer bumpfee if the payment should still go out.",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			_, err := client.AbandonTransaction(context.Background(), &pb.AbandonTransactionRequest{Txid: abandonTxid})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+			fmt.Printf("Abandoned transaction %s\n", abandonTxid)
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(abandonCmd)
+
+	abandonCmd.PersistentFlags().StringVar(&abandonTxid, "txid", "", "txid of the transaction that should be abandoned")
+	err := cobra.MarkFlagRequired(abandonCmd.PersistentFlags(), "txid")
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
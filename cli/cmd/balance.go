@@ -86,14 +86,14 @@ var (
 
 			if listUTXOs {
 				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-				_, err := fmt.Fprintln(writer, "UTXO Outpoint\tAmount\tState\tLabel")
+				_, err := fmt.Fprintln(writer, "UTXO Outpoint\tAmount\tState\tSource\tLabel")
 				if err != nil {
 					log.Fatalln(err)
 				}
 
 				for _, utxo := range filteredUTXOs {
 					amount := lib.ConvertIntToThousandString(int(utxo.Amount))
-					output := fmt.Sprintf("%x:%d\t%s\t%s", utxo.Txid, utxo.Vout, amount, utxo.UtxoState)
+					output := fmt.Sprintf("%x:%d\t%s\t%s\t%s", utxo.Txid, utxo.Vout, amount, utxo.UtxoState, utxo.Source)
 					if utxo.Label != nil && utxo.Label.Comment != "" {
 						output += fmt.Sprintf("\t%s", utxo.Label.Comment)
 					} else {
@@ -20,7 +20,10 @@ var (
 		Use:   "broadcast",
 		Short: "broadcast a raw transaction",
 		Long: `This command allows you to broadcast any valid transaction 
-to the wider bitcoin network.`,
+to the wider bitcoin network.
+UTXOs of the wallet spent by the transaction are marked as spent 
+and outputs paying to the wallet are added as unconfirmed, 
+also for transactions which were signed elsewhere.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			client, conn := lib.NewClient(socketPath)
 			defer func(conn *grpc.ClientConn) {
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// bumpfeeCmd represents the bumpfee command
+var (
+	bumpTxid      string
+	bumpFeeRate   float64
+	bumpBroadcast bool
+
+	bumpfeeCmd = &cobra.Command{
+		Use:   "bumpfee",
+		Short: "Replace a stuck transaction with a higher fee rate (RBF)",
+		Long: "This command replaces an unconfirmed transaction created by this wallet with a transaction paying a higher fee rate.\n" +
+			"The replacement spends the same inputs and pays the same recipients. The change is reduced first,\n" +
+			"further inputs are only added if needed. Silent payment outputs are recomputed for the new set of inputs.\n" +
+			"By default the raw replacement transaction is printed. Use --broadcast to broadcast it directly.\n" +
+			"The original transaction is only marked as replaced once the replacement was broadcast, e.g. with the broadcast command.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if bumpFeeRate <= 0 {
+				log.Fatalln("sat_per_byte has to be greater than 0, got:", bumpFeeRate)
+			}
+
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			resp, err := client.BumpFee(context.Background(), &pb.BumpFeeRequest{
+				Txid:             bumpTxid,
+				FeeRateMilliSats: uint64(math.Round(bumpFeeRate * 1000)),
+				Broadcast:        bumpBroadcast,
+			})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+
+			fmt.Printf("txid: %s\n", resp.Txid)
+			fmt.Printf("fee: %s sats\n", lib.ConvertIntToThousandString(int(resp.Fee)))
+			if !bumpBroadcast {
+				fmt.Printf("rawTx: %x\n", resp.RawTx)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(bumpfeeCmd)
+
+	bumpfeeCmd.PersistentFlags().StringVar(&bumpTxid, "txid", "", "txid of the transaction that should be replaced")
+	bumpfeeCmd.PersistentFlags().Float64Var(&bumpFeeRate, "sat_per_byte", 0, "new fee rate (in sats/vByte) for the replacement. Has to be higher than the original fee rate")
+	bumpfeeCmd.PersistentFlags().BoolVar(&bumpBroadcast, "broadcast", false, "broadcasts the replacement directly")
+
+	err := cobra.MarkFlagRequired(bumpfeeCmd.PersistentFlags(), "txid")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = cobra.MarkFlagRequired(bumpfeeCmd.PersistentFlags(), "sat_per_byte")
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// cpfpCmd represents the cpfp command
+var (
+	cpfpTxid      string
+	cpfpFeeRate   float64
+	cpfpBroadcast bool
+
+	cpfpCmd = &cobra.Command{
+		Use:   "cpfp",
+		Short: "Speed up an unconfirmed incoming transaction (CPFP)",
+		Long: "This command creates a child transaction which spends the outputs of an unconfirmed transaction paying to this wallet\n" +
+			"back to the change label. The fee of the child is chosen such that parent and child together pay the given fee rate.\n" +
+			"Further inputs are only added if the received outputs can't cover the fee.\n" +
+			"By default the raw child transaction is printed. Use --broadcast to broadcast it directly.\n" +
+			"The spent outputs are only marked as spent once the child was broadcast, e.g. with the broadcast command.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if cpfpFeeRate <= 0 {
+				log.Fatalln("sat_per_byte has to be greater than 0, got:", cpfpFeeRate)
+			}
+
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			resp, err := client.CpfpBump(context.Background(), &pb.CpfpBumpRequest{
+				Txid:             cpfpTxid,
+				FeeRateMilliSats: uint64(math.Round(cpfpFeeRate * 1000)),
+				Broadcast:        cpfpBroadcast,
+			})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+
+			fmt.Printf("txid: %s\n", resp.Txid)
+			fmt.Printf("fee: %s sats\n", lib.ConvertIntToThousandString(int(resp.Fee)))
+			if !cpfpBroadcast {
+				fmt.Printf("rawTx: %x\n", resp.RawTx)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(cpfpCmd)
+
+	cpfpCmd.PersistentFlags().StringVar(&cpfpTxid, "txid", "", "txid of the unconfirmed transaction which pays to this wallet")
+	cpfpCmd.PersistentFlags().Float64Var(&cpfpFeeRate, "sat_per_byte", 0, "target fee rate (in sats/vByte) for parent and child together")
+	cpfpCmd.PersistentFlags().BoolVar(&cpfpBroadcast, "broadcast", false, "broadcasts the child transaction directly")
+
+	err := cobra.MarkFlagRequired(cpfpCmd.PersistentFlags(), "txid")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	err = cobra.MarkFlagRequired(cpfpCmd.PersistentFlags(), "sat_per_byte")
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 
 	"github.com/setavenger/blindbitd/cli/lib"
 	"github.com/setavenger/blindbitd/pb"
@@ -16,10 +17,15 @@ import (
 var (
 	addresses   []string
 	amounts     []int64
-	feeRate     int64
+	feeRate     float64
+	absoluteFee uint64
+	confTarget  uint32
 	annotations []string
+	payoutFile  string
+	uris        []string
 
 	broadcast           bool
+	dryRun              bool
 	notMarkSpent        bool
 	useSpentUnconfirmed bool
 
@@ -32,21 +38,39 @@ var (
 			"Then the command will output the txid of the created transaction.\n" +
 			"UTXOs used in a transaction are automatically marked as spent_unconfirmed.\n" +
 			"Use --notmarkspent to not do this.\n" +
-			"Use --usespent to include spent_unconfirmed UTXOs in transaction creation.",
+			"Use --usespent to include spent_unconfirmed UTXOs in transaction creation.\n" +
+			"The fee is either set as a fee rate with --sat_per_byte (fractional values like 1.5 are allowed),\n" +
+			"as an absolute fee in sats with --fee or estimated by the daemon for a confirmation target with --conf_target.\n" +
+			"Use --dry-run to preview the inputs, outputs and fee of the transaction without signing or marking anything.\n" +
+			"Use --from-file to read the recipients from a payout file instead of --addr/--amt/--note.\n" +
+			"CSV files have one recipient per row in the format `address,amount[,note]`,\n" +
+			"JSON files contain a list of objects like `{\"address\": \"sp1...\", \"amount\": 10000, \"note\": \"...\"}`.\n" +
+			"Amounts are in sats. The daemon checks all recipients before building the transaction and reports every invalid row.\n" +
+			"Use --uri to pay BIP 21 URIs like `bitcoin:bc1q...?sp=sp1...&amount=0.001&label=invoice`.\n" +
+			"The silent payment address of the URI is preferred, amount and label/message are taken from the URI.\n" +
+			"URIs can also be used as address in payout files, the amount can then be left empty.",
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(addresses) < 1 {
-				log.Fatalln("needs at least one address")
+			var feeOptionsSet int
+			for _, isSet := range []bool{feeRate != 0, absoluteFee != 0, confTarget != 0} {
+				if isSet {
+					feeOptionsSet++
+				}
+			}
+			if feeOptionsSet == 0 {
+				log.Fatalln("one of --sat_per_byte, --fee or --conf_target is required")
 			}
-			if len(addresses) != len(amounts) {
-				log.Fatalf("different number of addresses (%d) and amounts (%d)", len(addresses), len(amounts))
+			if feeOptionsSet > 1 {
+				log.Fatalln("only one of --sat_per_byte, --fee or --conf_target can be used")
 			}
-			if len(annotations) > 0 && len(addresses) != len(annotations) {
-				log.Fatalf("number annotations (%d) does not match addresses (%d). When using annotations the number of annotations has to be the same as addresses/amounts. Use `--note \"\"` for recipients without annotations.", len(annotations), len(addresses))
+			if feeRate < 0 {
+				log.Fatalln("feeRate can't be negative, got:", feeRate)
 			}
-			if feeRate == 0 {
-				log.Fatalln("feeRate is required, got:", feeRate)
+			if dryRun && broadcast {
+				log.Fatalln("--dry-run can't be used together with --broadcast")
 			}
 
+			recipients := readRecipients(addresses, amounts, annotations, uris, payoutFile)
+
 			client, conn := lib.NewClient(socketPath)
 			defer func(conn *grpc.ClientConn) {
 				err := conn.Close()
@@ -55,28 +79,22 @@ var (
 				}
 			}(conn)
 
-			var recipients []*pb.TransactionRecipient
-			for i, addr := range addresses {
-				recipient := &pb.TransactionRecipient{
-					Address: addr,
-					Amount:  uint64(amounts[i]),
-				}
-				if len(annotations) > 0 {
-					// we checked the lengths above already
-					recipient.Annotation = annotations[i]
-				}
-
-				recipients = append(recipients, recipient)
-			}
-
 			transactionParams := &pb.CreateTransactionRequest{
 				Recipients:          recipients,
-				FeeRate:             feeRate,
+				FeeRateMilliSats:    uint64(math.Round(feeRate * 1000)),
+				AbsoluteFee:         absoluteFee,
+				ConfTarget:          confTarget,
 				MarkSpent:           !notMarkSpent,
 				UseSpentUnconfirmed: useSpentUnconfirmed,
 			}
 
-			if broadcast {
+			if dryRun {
+				preview, err := client.PreviewTransaction(context.Background(), transactionParams)
+				if err != nil {
+					log.Fatalln("Error:", err)
+				}
+				printTransactionPreview(preview)
+			} else if broadcast {
 				txid, err := client.CreateTransactionAndBroadcast(context.Background(), transactionParams)
 				if err != nil {
 					log.Fatalln("Error:", err)
@@ -94,29 +112,99 @@ var (
 	}
 )
 
+// readRecipients
+// builds the recipients either from the payout file or the --addr/--amt/--note and --uri flags. Exits on invalid input.
+// The daemon resolves the URIs.
+func readRecipients(addresses []string, amounts []int64, annotations, uris []string, payoutFile string) []*pb.TransactionRecipient {
+	if payoutFile != "" && (len(addresses) > 0 || len(amounts) > 0 || len(annotations) > 0 || len(uris) > 0) {
+		log.Fatalln("--from-file can't be used together with --addr, --amt, --note or --uri")
+	}
+
+	if payoutFile != "" {
+		recipients, err := lib.ReadPayoutFile(payoutFile)
+		if err != nil {
+			log.Fatalf("could not read payout file:\n%v\n", err)
+		}
+		if len(recipients) < 1 {
+			log.Fatalln("payout file has no recipients")
+		}
+		return recipients
+	}
+
+	if len(addresses) < 1 && len(uris) < 1 {
+		log.Fatalln("needs at least one address or uri")
+	}
+	if len(addresses) != len(amounts) {
+		log.Fatalf("different number of addresses (%d) and amounts (%d)", len(addresses), len(amounts))
+	}
+	if len(annotations) > 0 && len(addresses) != len(annotations) {
+		log.Fatalf("number annotations (%d) does not match addresses (%d). When using annotations the number of annotations has to be the same as addresses/amounts. Use `--note \"\"` for recipients without annotations.", len(annotations), len(addresses))
+	}
+
+	var recipients []*pb.TransactionRecipient
+	for i, addr := range addresses {
+		recipient := &pb.TransactionRecipient{
+			Address: addr,
+			Amount:  uint64(amounts[i]),
+		}
+		if len(annotations) > 0 {
+			// we checked the lengths above already
+			recipient.Annotation = annotations[i]
+		}
+
+		recipients = append(recipients, recipient)
+	}
+	for _, uri := range uris {
+		recipients = append(recipients, &pb.TransactionRecipient{Address: uri})
+	}
+	return recipients
+}
+
+func printTransactionPreview(preview *pb.TransactionPreview) {
+	fmt.Println("--- Transaction Preview ---")
+	fmt.Println("Inputs:")
+	for _, utxo := range preview.Inputs {
+		var label string
+		if utxo.Label != nil {
+			label = fmt.Sprintf(" - label %d: %s", utxo.Label.M, utxo.Label.Comment)
+		}
+		fmt.Printf("  %x:%d  %s sats%s\n", utxo.Txid, utxo.Vout, lib.ConvertIntToThousandString(int(utxo.Amount)), label)
+	}
+
+	fmt.Println("Outputs:")
+	for _, output := range preview.Outputs {
+		address := output.Address
+		if output.IsChange {
+			address = "(change)"
+		}
+		fmt.Printf("  %s  %s sats\n", address, lib.ConvertIntToThousandString(int(output.Amount)))
+	}
+
+	fmt.Printf("Estimated vSize: %.2f vB\n", preview.VsizeEstimate)
+	fmt.Printf("Fee:             %s sats (%.3f sat/vB)\n", lib.ConvertIntToThousandString(int(preview.Fee)), float64(preview.FeeRateMilliSats)/1000)
+
+	if len(preview.MarkedSpent) > 0 {
+		fmt.Println("Would be marked as spent_unconfirmed:")
+		for _, utxo := range preview.MarkedSpent {
+			fmt.Printf("  %x:%d\n", utxo.Txid, utxo.Vout)
+		}
+	}
+}
+
 func init() {
 	RootCmd.AddCommand(createtransactionCmd)
 
 	createtransactionCmd.PersistentFlags().StringSliceVar(&addresses, "addr", nil, "address you want to send to")
 	createtransactionCmd.PersistentFlags().Int64SliceVar(&amounts, "amt", nil, "amount you want to send to the address in satoshis [1 BTC = 100,000,000 sats]")
-	createtransactionCmd.PersistentFlags().Int64Var(&feeRate, "sat_per_byte", 0, "set the fee rate (in sats/vByte) for the transaction. Has to be an integer")
+	createtransactionCmd.PersistentFlags().Float64Var(&feeRate, "sat_per_byte", 0, "set the fee rate (in sats/vByte) for the transaction. Precision is up to 3 decimal places (e.g. 1.5)")
+	createtransactionCmd.PersistentFlags().Uint64Var(&absoluteFee, "fee", 0, "set the absolute fee (in sats) for the transaction. Can't be used together with --sat_per_byte")
+	createtransactionCmd.PersistentFlags().Uint32Var(&confTarget, "conf_target", 0, "let the daemon estimate the fee rate for confirmation within this number of blocks")
 	createtransactionCmd.PersistentFlags().StringSliceVar(&annotations, "note", nil, "add annotation to recipient")
 	//createtransactionCmd.PersistentFlags().StringVar(&annotation, "annotation", "", "add an annotation the recipient")  // todo not used in a meaningful way in daemon yet
+	createtransactionCmd.PersistentFlags().StringArrayVar(&uris, "uri", nil, "BIP 21 URI you want to pay, can be combined with --addr")
+	createtransactionCmd.PersistentFlags().StringVar(&payoutFile, "from-file", "", "read the recipients from a .csv or .json payout file")
 	createtransactionCmd.PersistentFlags().BoolVar(&broadcast, "broadcast", false, "broadcasts the transaction directly")
+	createtransactionCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "only show what the transaction would look like, nothing is signed or marked as spent")
 	createtransactionCmd.PersistentFlags().BoolVar(&notMarkSpent, "notmarkspent", false, "not mark utxos of the transaction as spent_unconfirmed")
 	createtransactionCmd.PersistentFlags().BoolVar(&useSpentUnconfirmed, "usespent", false, "include utxos with state spent_unconfirmed")
-
-	// required flags
-	err := cobra.MarkFlagRequired(createtransactionCmd.PersistentFlags(), "addr")
-	if err != nil {
-		log.Fatalln(err)
-	}
-	err = cobra.MarkFlagRequired(createtransactionCmd.PersistentFlags(), "amt")
-	if err != nil {
-		log.Fatalln(err)
-	}
-	err = cobra.MarkFlagRequired(createtransactionCmd.PersistentFlags(), "sat_per_byte")
-	if err != nil {
-		log.Fatalln(err)
-	}
 }
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// decodeaddressCmd represents the decodeaddress command
+var (
+	decodeaddressCmd = &cobra.Command{
+		Use:   "decodeaddress <address>",
+		Short: "Decode and validate an address",
+		Long: "Shows the content of a silent payment or regular address and whether it belongs to this wallet.\n" +
+			"Silent payment addresses are fully decoded and show the version, network, scan and spend public keys.\n" +
+			"For labelled addresses of this wallet the label m is shown. Regular addresses show their script type.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			info, err := client.ValidateAddress(context.Background(), &pb.ValidateAddressRequest{Address: args[0]})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			rows := [][2]string{
+				{"Address", info.Address},
+				{"Valid", fmt.Sprint(info.IsValid)},
+			}
+			if !info.IsValid {
+				rows = append(rows, [2]string{"Error", info.Error})
+			} else {
+				rows = append(rows,
+					[2]string{"Network", info.Network},
+					[2]string{"Wallet network", fmt.Sprint(info.IsForWalletNetwork)},
+				)
+				if info.IsSilentPayment {
+					rows = append(rows,
+						[2]string{"Type", "silent payment"},
+						[2]string{"Version", fmt.Sprint(info.Version)},
+						[2]string{"Scan pubkey", fmt.Sprintf("%x", info.ScanPubKey)},
+						[2]string{"Spend pubkey", fmt.Sprintf("%x", info.SpendPubKey)},
+					)
+				} else {
+					rows = append(rows,
+						[2]string{"Type", info.ScriptType},
+						[2]string{"Script", fmt.Sprintf("%x", info.PkScript)},
+					)
+				}
+				rows = append(rows, [2]string{"Mine", fmt.Sprint(info.IsMine)})
+				if info.LabelM != nil {
+					rows = append(rows, [2]string{"Label m", fmt.Sprint(*info.LabelM)})
+				}
+				if info.Comment != "" {
+					rows = append(rows, [2]string{"Comment", info.Comment})
+				}
+			}
+
+			for _, row := range rows {
+				_, err = fmt.Fprintf(writer, "%s:\t%s\n", row[0], row[1])
+				if err != nil {
+					log.Fatalln(err)
+				}
+			}
+			err = writer.Flush()
+			if err != nil {
+				log.Fatalln(err)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(decodeaddressCmd)
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// estimatefeeCmd represents the estimatefee command
+var (
+	confTargets []uint
+
+	estimatefeeCmd = &cobra.Command{
+		Use:   "estimatefee",
+		Short: "Estimate fee rates for confirmation targets",
+		Long: "Shows the fee rate (in sats/vByte) which should get a transaction confirmed within the given number of blocks.\n" +
+			"Estimates come from Electrum or the indexing server and are cached by the daemon for a few minutes.",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, err := fmt.Fprintln(writer, "Target\tsat/vByte\tSource\tFetched")
+			if err != nil {
+				log.Fatalln(err)
+			}
+			for _, target := range confTargets {
+				estimate, err := client.EstimateFee(context.Background(), &pb.EstimateFeeRequest{ConfTarget: uint32(target)})
+				if err != nil {
+					log.Fatalf("could not estimate fee for target %d: %v\n", target, err)
+				}
+				_, err = fmt.Fprintf(writer, "%d\t%.3f\t%s\t%s\n",
+					estimate.ConfTarget,
+					float64(estimate.FeeRateMilliSats)/1000,
+					estimate.Source,
+					estimate.Timestamp.AsTime().Local().Format(time.DateTime),
+				)
+				if err != nil {
+					log.Fatalln(err)
+				}
+			}
+			err = writer.Flush()
+			if err != nil {
+				log.Fatalln(err)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(estimatefeeCmd)
+
+	estimatefeeCmd.PersistentFlags().UintSliceVar(&confTargets, "conf_target", []uint{1, 3, 6, 144}, "confirmation targets in blocks")
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// importutxoCmd represents the importutxo command
+var (
+	importTxid string
+	importVout uint32
+
+	importutxoCmd = &cobra.Command{
+		Use:   "importutxo",
+		Short: "Import an output received on a regular address of this wallet",
+		Long: "This command imports a confirmed output which was received on a regular P2WPKH (BIP 84) or P2TR (BIP 86)\n" +
+			"address derived from the same seed as the silent payment keys.\n" +
+			"Imported outputs are spent together with the silent payment outputs of the wallet.\n" +
+			"Wallets created before regular accounts were supported have to be recovered from seed first.",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			utxo, err := client.ImportUTXO(context.Background(), &pb.ImportUTXORequest{
+				Txid: importTxid,
+				Vout: importVout,
+			})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+
+			fmt.Printf("Imported %x:%d - %s sats\n", utxo.Txid, utxo.Vout, lib.ConvertIntToThousandString(int(utxo.Amount)))
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(importutxoCmd)
+
+	importutxoCmd.PersistentFlags().StringVar(&importTxid, "txid", "", "txid of the transaction which contains the output")
+	importutxoCmd.PersistentFlags().Uint32Var(&importVout, "vout", 0, "index of the output")
+
+	err := cobra.MarkFlagRequired(importutxoCmd.PersistentFlags(), "txid")
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+)
+
+// pendingCmd represents the pending command
+var (
+	pendingCmd = &cobra.Command{
+		Use:   "pending",
+		Short: "Lists outgoing transactions which are not confirmed yet",
+		Long: "Pending transactions are broadcast again periodically until they confirm.\n" +
+			"Transactions which are not confirmed within the drop timeout are shown as dropped and not broadcast anymore.\n" +
+			"Bump them with bumpfee or give up on them with abandon, which makes their inputs spendable again.",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			pending, err := client.ListPendingTransactions(context.Background(), &pb.Empty{})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, err = fmt.Fprintln(writer, "Txid\tState\tAmount\tFee\tFirst broadcast\tLast broadcast")
+			if err != nil {
+				log.Fatalln(err)
+			}
+			for _, tx := range pending.Transactions {
+				state := strings.ToLower(strings.TrimPrefix(tx.State.String(), "TX_"))
+				if tx.External {
+					state += " (external)"
+				}
+				_, err = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					tx.Txid,
+					state,
+					lib.ConvertIntToThousandString(int(tx.Amount)),
+					lib.ConvertIntToThousandString(int(tx.Fee)),
+					tx.FirstBroadcast.AsTime().Local().Format(time.RFC3339),
+					tx.LastBroadcast.AsTime().Local().Format(time.RFC3339),
+				)
+				if err != nil {
+					log.Fatalln(err)
+				}
+			}
+			err = writer.Flush()
+			if err != nil {
+				log.Fatalln(err)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(pendingCmd)
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+	"github.com/setavenger/blindbitd/pb"
+)
+
+// scheduleCmd represents the schedule command
+var (
+	scheduleAddresses   []string
+	scheduleAmounts     []int64
+	scheduleAnnotations []string
+	schedulePayoutFile  string
+	scheduleURIs        []string
+	scheduleComment     string
+
+	scheduleHeight      uint64
+	scheduleTime        string
+	scheduleEveryBlocks uint64
+	scheduleEvery       time.Duration
+	scheduleEveryMonths uint32
+
+	scheduleFeeRate    float64
+	scheduleConfTarget uint32
+	scheduleMaxFeeRate float64
+	scheduleMaxFee     uint64
+
+	scheduleId      string
+	scheduleShowLog bool
+
+	scheduleCmd = &cobra.Command{
+		Use:   "schedule",
+		Short: "Operations related to scheduled and recurring sends",
+		Long: "Schedules are executed by the daemon at a block height or a point in time and can repeat.\n" +
+			"The daemon has to be unlocked for schedules to be executed.\n" +
+			"Every execution and failure is recorded in the log of the schedule.",
+		// no Run so it goes directly to help
+	}
+
+	scheduleCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Creates a new schedule",
+		Long: "Recipients are set like for createtransaction with --addr/--amt/--note, --uri or --from-file.\n" +
+			"Set the first execution with either --height or --time (RFC 3339, e.g. 2024-06-01T12:00:00Z).\n" +
+			"Repeat with --every_blocks (only with --height), --every (e.g. 168h) or --every_months (only with --time).\n" +
+			"The fee rate is fixed with --sat_per_byte or estimated at execution for --conf_target.\n" +
+			"Executions above --max_sat_per_byte or --max_fee are skipped and retried with the next block.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if (scheduleFeeRate == 0) == (scheduleConfTarget == 0) {
+				log.Fatalln("one of --sat_per_byte or --conf_target is required")
+			}
+			if scheduleFeeRate < 0 || scheduleMaxFeeRate < 0 {
+				log.Fatalln("fee rates can't be negative")
+			}
+			if (scheduleHeight == 0) == (scheduleTime == "") {
+				log.Fatalln("one of --height or --time is required")
+			}
+
+			schedule := &pb.Schedule{
+				Recipients:          readRecipients(scheduleAddresses, scheduleAmounts, scheduleAnnotations, scheduleURIs, schedulePayoutFile),
+				Comment:             scheduleComment,
+				StartHeight:         scheduleHeight,
+				IntervalBlocks:      scheduleEveryBlocks,
+				IntervalSeconds:     uint64(scheduleEvery / time.Second),
+				IntervalMonths:      scheduleEveryMonths,
+				FeeRateMilliSats:    uint64(math.Round(scheduleFeeRate * 1000)),
+				ConfTarget:          scheduleConfTarget,
+				MaxFeeRateMilliSats: uint64(math.Round(scheduleMaxFeeRate * 1000)),
+				MaxFee:              scheduleMaxFee,
+			}
+			if scheduleTime != "" {
+				startTime, err := time.Parse(time.RFC3339, scheduleTime)
+				if err != nil {
+					log.Fatalf("invalid --time: %v\n", err)
+				}
+				schedule.StartTime = timestamppb.New(startTime)
+			}
+
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			created, err := client.CreateSchedule(context.Background(), schedule)
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+			fmt.Printf("Created schedule %s, next execution: %s\n", created.Id, formatNextExecution(created))
+		},
+	}
+
+	scheduleListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "Lists all schedules",
+		Long:  `This command shows all schedules. Use --log to also show the executions and failures of every schedule.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			schedules, err := client.ListSchedules(context.Background(), &pb.Empty{})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, err = fmt.Fprintln(writer, "Id\tActive\tRecipients\tAmount\tNext\tExecutions\tComment")
+			if err != nil {
+				log.Fatalln(err)
+			}
+			for _, schedule := range schedules.Schedules {
+				var amount uint64
+				for _, recipient := range schedule.Recipients {
+					amount += recipient.Amount
+				}
+				_, err = fmt.Fprintf(writer, "%s\t%t\t%d\t%s\t%s\t%d\t%s\n",
+					schedule.Id,
+					schedule.Active,
+					len(schedule.Recipients),
+					lib.ConvertIntToThousandString(int(amount)),
+					formatNextExecution(schedule),
+					len(schedule.Executions),
+					schedule.Comment,
+				)
+				if err != nil {
+					log.Fatalln(err)
+				}
+			}
+			err = writer.Flush()
+			if err != nil {
+				log.Fatalln(err)
+			}
+
+			if !scheduleShowLog {
+				return
+			}
+			for _, schedule := range schedules.Schedules {
+				if len(schedule.Executions) == 0 {
+					continue
+				}
+				fmt.Printf("\n--- %s ---\n", schedule.Id)
+				for _, execution := range schedule.Executions {
+					status := "sent " + execution.Txid
+					if execution.Error != "" {
+						status = "failed: " + execution.Error
+					}
+					fmt.Printf("%s  height %d  fee %d sats (%.3f sat/vB)  %s\n",
+						execution.Timestamp.AsTime().Format(time.RFC3339),
+						execution.Height,
+						execution.Fee,
+						float64(execution.FeeRateMilliSats)/1000,
+						status,
+					)
+				}
+			}
+		},
+	}
+
+	scheduleDeleteCmd = &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a schedule",
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+
+			_, err := client.DeleteSchedule(context.Background(), &pb.DeleteScheduleRequest{Id: scheduleId})
+			if err != nil {
+				log.Fatalln("Error:", err)
+			}
+			fmt.Printf("Deleted schedule %s\n", scheduleId)
+		},
+	}
+)
+
+func formatNextExecution(schedule *pb.Schedule) string {
+	switch {
+	case !schedule.Active:
+		return "-"
+	case schedule.NextHeight > 0:
+		return fmt.Sprintf("height %d", schedule.NextHeight)
+	case schedule.NextTime != nil:
+		return schedule.NextTime.AsTime().Local().Format(time.RFC3339)
+	default:
+		return "-"
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(scheduleCmd)
+	scheduleCmd.AddCommand(scheduleCreateCmd)
+	scheduleCmd.AddCommand(scheduleListCmd)
+	scheduleCmd.AddCommand(scheduleDeleteCmd)
+
+	scheduleCreateCmd.PersistentFlags().StringSliceVar(&scheduleAddresses, "addr", nil, "address you want to send to")
+	scheduleCreateCmd.PersistentFlags().Int64SliceVar(&scheduleAmounts, "amt", nil, "amount you want to send to the address in satoshis [1 BTC = 100,000,000 sats]")
+	scheduleCreateCmd.PersistentFlags().StringSliceVar(&scheduleAnnotations, "note", nil, "add annotation to recipient")
+	scheduleCreateCmd.PersistentFlags().StringArrayVar(&scheduleURIs, "uri", nil, "BIP 21 URI you want to pay")
+	scheduleCreateCmd.PersistentFlags().StringVar(&schedulePayoutFile, "from-file", "", "read the recipients from a .csv or .json payout file")
+	scheduleCreateCmd.PersistentFlags().StringVar(&scheduleComment, "comment", "", "set a comment for the schedule")
+	scheduleCreateCmd.PersistentFlags().Uint64Var(&scheduleHeight, "height", 0, "first execution at this block height")
+	scheduleCreateCmd.PersistentFlags().StringVar(&scheduleTime, "time", "", "first execution at this time (RFC 3339)")
+	scheduleCreateCmd.PersistentFlags().Uint64Var(&scheduleEveryBlocks, "every_blocks", 0, "repeat every n blocks")
+	scheduleCreateCmd.PersistentFlags().DurationVar(&scheduleEvery, "every", 0, "repeat after this duration (e.g. 168h)")
+	scheduleCreateCmd.PersistentFlags().Uint32Var(&scheduleEveryMonths, "every_months", 0, "repeat every n calendar months")
+	scheduleCreateCmd.PersistentFlags().Float64Var(&scheduleFeeRate, "sat_per_byte", 0, "fixed fee rate (in sats/vByte) for every execution")
+	scheduleCreateCmd.PersistentFlags().Uint32Var(&scheduleConfTarget, "conf_target", 0, "estimate the fee rate for confirmation within this number of blocks at every execution")
+	scheduleCreateCmd.PersistentFlags().Float64Var(&scheduleMaxFeeRate, "max_sat_per_byte", 0, "skip executions above this fee rate (in sats/vByte)")
+	scheduleCreateCmd.PersistentFlags().Uint64Var(&scheduleMaxFee, "max_fee", 0, "skip executions above this absolute fee (in sats)")
+
+	scheduleListCmd.PersistentFlags().BoolVar(&scheduleShowLog, "log", false, "show the executions and failures of every schedule")
+
+	scheduleDeleteCmd.PersistentFlags().StringVar(&scheduleId, "id", "", "id of the schedule")
+	err := cobra.MarkFlagRequired(scheduleDeleteCmd.PersistentFlags(), "id")
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/setavenger/blindbitd/pb"
 	"google.golang.org/grpc"
 
@@ -30,6 +32,19 @@ var statusCmd = &cobra.Command{
 			return
 		}
 		fmt.Printf("Status: %v\n", statusResponse.Status)
+
+		electrum := statusResponse.Electrum
+		if electrum == nil {
+			return
+		}
+		fmt.Printf("Electrum: %v %s\n", electrum.State, electrum.Server)
+		if electrum.State == pb.ElectrumConnectionState_ELECTRUM_CONNECTED {
+			fmt.Printf("Connected since: %s\n", electrum.ConnectedSince.AsTime().Local().Format(time.RFC3339))
+		}
+		fmt.Printf("Reconnects: %d\n", electrum.Reconnects)
+		if electrum.LastError != "" {
+			fmt.Printf("Last error: %s\n", electrum.LastError)
+		}
 	},
 }
 
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/setavenger/blindbitd/pb"
+
+	"github.com/setavenger/blindbitd/cli/lib"
+)
+
+// taprootCmd represents the taproot command
+var (
+	taprootCmd = &cobra.Command{
+		Use:   "taproot",
+		Short: "Operations related to regular taproot addresses",
+		Long: "Regular taproot addresses (BIP 86) are derived from the same seed as the silent payment keys.\n" +
+			"They can be used to receive from services which can't pay to silent payment addresses yet.\n" +
+			"Funds received on those addresses are spent together with the silent payment funds.",
+		// no Run so it goes directly to help
+	}
+
+	newTaprootAddressComment string
+
+	taprootNewCmd = &cobra.Command{
+		Use:   "new",
+		Short: "Creates a new taproot address",
+		Long:  `This command derives the next unused taproot address of the wallet`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+			address, err := client.NewTaprootAddress(ctx, &pb.NewLabelRequest{Comment: newTaprootAddressComment})
+			if err != nil {
+				log.Fatalf("could not create new taproot address: %v\n", err)
+			}
+			fmt.Printf("New taproot address: %s\n", address.Address)
+		},
+	}
+
+	taprootListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "Lists all taproot addresses",
+		Long:  `This command shows all taproot addresses in the order they were created.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, conn := lib.NewClient(socketPath)
+			defer func(conn *grpc.ClientConn) {
+				err := conn.Close()
+				if err != nil {
+					panic(err)
+				}
+			}(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			addresses, err := client.ListTaprootAddresses(ctx, &pb.Empty{})
+			if err != nil {
+				log.Fatalf("could not retrieve taproot addresses: %v\n", err)
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, err = fmt.Fprintln(writer, "Address\tComment")
+			if err != nil {
+				log.Fatalln(err)
+			}
+			for _, address := range addresses.Addresses {
+				_, err = fmt.Fprintf(writer, "%s\t%s\n", address.Address, address.Comment)
+				if err != nil {
+					log.Fatalln(err)
+				}
+			}
+			err = writer.Flush()
+			if err != nil {
+				log.Fatalln(err)
+			}
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(taprootCmd)
+	taprootCmd.AddCommand(taprootNewCmd)
+	taprootCmd.AddCommand(taprootListCmd)
+
+	taprootNewCmd.PersistentFlags().StringVar(&newTaprootAddressComment, "comment", "", "Set a comment for the new address.")
+}
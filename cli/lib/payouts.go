@@ -0,0 +1,112 @@
+package lib
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/setavenger/blindbitd/pb"
+)
+
+// PayoutRow is one recipient in a JSON payout file
+type PayoutRow struct {
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+	Note    string `json:"note,omitempty"`
+}
+
+// ReadPayoutFile
+// reads the recipients from a .csv or .json payout file.
+// CSV rows have the format `address,amount[,note]`, a header row starting with `address` is skipped.
+// The address can be a BIP 21 URI, the amount can then be left empty to use the amount of the URI.
+// JSON files contain a list of objects with the keys `address`, `amount` and `note`.
+// Amounts are in sats. All rows which can't be parsed are reported together.
+func ReadPayoutFile(path string) ([]*pb.TransactionRecipient, error) {
+	file, err := os.Open(ResolvePath(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readPayoutCSV(file)
+	case ".json":
+		return readPayoutJSON(file)
+	default:
+		return nil, fmt.Errorf("unsupported payout file %s, use .csv or .json", path)
+	}
+}
+
+func readPayoutCSV(reader io.Reader) ([]*pb.TransactionRecipient, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+	csvReader.Comment = '#'
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && strings.EqualFold(strings.TrimSpace(records[0][0]), "address") {
+		records = records[1:]
+	}
+
+	var recipients []*pb.TransactionRecipient
+	var rowErrors []string
+	for i, record := range records {
+		row := i + 1
+		if len(record) < 2 || len(record) > 3 {
+			rowErrors = append(rowErrors, fmt.Sprintf("row %d: expected address,amount[,note] got %d fields", row, len(record)))
+			continue
+		}
+		var amount uint64
+		var err error
+		if amountField := strings.TrimSpace(record[1]); amountField != "" || !strings.HasPrefix(strings.ToLower(record[0]), "bitcoin:") {
+			amount, err = strconv.ParseUint(amountField, 10, 64)
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("row %d: invalid amount %q", row, record[1]))
+			continue
+		}
+		recipient := &pb.TransactionRecipient{
+			Address: strings.TrimSpace(record[0]),
+			Amount:  amount,
+		}
+		if len(record) == 3 {
+			recipient.Annotation = record[2]
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, errors.New(strings.Join(rowErrors, "\n"))
+	}
+	return recipients, nil
+}
+
+func readPayoutJSON(reader io.Reader) ([]*pb.TransactionRecipient, error) {
+	var rows []PayoutRow
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipients []*pb.TransactionRecipient
+	for _, row := range rows {
+		recipients = append(recipients, &pb.TransactionRecipient{
+			Address:    strings.TrimSpace(row.Address),
+			Amount:     row.Amount,
+			Annotation: row.Note,
+		})
+	}
+	return recipients, nil
+}
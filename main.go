@@ -13,7 +13,6 @@ import (
 	"github.com/setavenger/blindbitd/src/logging"
 	"github.com/setavenger/blindbitd/src/networking"
 	"github.com/setavenger/blindbitd/src/utils"
-	"github.com/setavenger/go-electrum/electrum"
 )
 
 var testEnvironment bool
@@ -66,20 +65,21 @@ func main() {
 		logging.DebugLogger.Println("config loaded")
 
 		// create the daemon but locked and without Wallet data
-		clientBlindBit := networking.ClientBlindBit{BaseUrl: src.BlindBitServerAddress}
-		var clientElectrum *electrum.Client
-		var err error
+		indexer, err := networking.CreateIndexer()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			panic(err)
+		}
+		var electrumSupervisor *networking.ElectrumSupervisor
 
 		if src.UseElectrum {
 			logging.DebugLogger.Println("connecting to Electrum server")
-			clientElectrum, err = networking.CreateElectrumClient()
-			if err != nil {
-				logging.ErrorLogger.Println(err)
-				panic(err)
-			}
+			electrumSupervisor = networking.CreateElectrumSupervisor()
+			// keeps reconnecting in the background if no server is reachable
+			electrumSupervisor.Start()
 		}
 
-		d, err = daemon.NewDaemon(nil, &clientBlindBit, clientElectrum)
+		d, err = daemon.NewDaemon(nil, indexer, electrumSupervisor)
 		if err != nil {
 			logging.ErrorLogger.Println(err)
 			panic(err)
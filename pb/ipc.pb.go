@@ -82,6 +82,55 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 	return file_ipc_proto_rawDescGZIP(), []int{0}
 }
 
+type ElectrumConnectionState int32
+
+const (
+	ElectrumConnectionState_ELECTRUM_DISCONNECTED ElectrumConnectionState = 0
+	ElectrumConnectionState_ELECTRUM_CONNECTING   ElectrumConnectionState = 1
+	ElectrumConnectionState_ELECTRUM_CONNECTED    ElectrumConnectionState = 2
+)
+
+// Enum value maps for ElectrumConnectionState.
+var (
+	ElectrumConnectionState_name = map[int32]string{
+		0: "ELECTRUM_DISCONNECTED",
+		1: "ELECTRUM_CONNECTING",
+		2: "ELECTRUM_CONNECTED",
+	}
+	ElectrumConnectionState_value = map[string]int32{
+		"ELECTRUM_DISCONNECTED": 0,
+		"ELECTRUM_CONNECTING":   1,
+		"ELECTRUM_CONNECTED":    2,
+	}
+)
+
+func (x ElectrumConnectionState) Enum() *ElectrumConnectionState {
+	p := new(ElectrumConnectionState)
+	*p = x
+	return p
+}
+
+func (x ElectrumConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElectrumConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ipc_proto_enumTypes[1].Descriptor()
+}
+
+func (ElectrumConnectionState) Type() protoreflect.EnumType {
+	return &file_ipc_proto_enumTypes[1]
+}
+
+func (x ElectrumConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElectrumConnectionState.Descriptor instead.
+func (ElectrumConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{1}
+}
+
 type UTXOState int32
 
 const (
@@ -121,11 +170,11 @@ func (x UTXOState) String() string {
 }
 
 func (UTXOState) Descriptor() protoreflect.EnumDescriptor {
-	return file_ipc_proto_enumTypes[1].Descriptor()
+	return file_ipc_proto_enumTypes[2].Descriptor()
 }
 
 func (UTXOState) Type() protoreflect.EnumType {
-	return &file_ipc_proto_enumTypes[1]
+	return &file_ipc_proto_enumTypes[2]
 }
 
 func (x UTXOState) Number() protoreflect.EnumNumber {
@@ -134,7 +183,111 @@ func (x UTXOState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UTXOState.Descriptor instead.
 func (UTXOState) EnumDescriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{1}
+	return file_ipc_proto_rawDescGZIP(), []int{2}
+}
+
+type UTXOSource int32
+
+const (
+	UTXOSource_SOURCE_SILENT_PAYMENT  UTXOSource = 0
+	UTXOSource_SOURCE_TAPROOT_ACCOUNT UTXOSource = 1 // BIP 86
+	UTXOSource_SOURCE_SEGWIT_ACCOUNT  UTXOSource = 2 // BIP 84
+)
+
+// Enum value maps for UTXOSource.
+var (
+	UTXOSource_name = map[int32]string{
+		0: "SOURCE_SILENT_PAYMENT",
+		1: "SOURCE_TAPROOT_ACCOUNT",
+		2: "SOURCE_SEGWIT_ACCOUNT",
+	}
+	UTXOSource_value = map[string]int32{
+		"SOURCE_SILENT_PAYMENT":  0,
+		"SOURCE_TAPROOT_ACCOUNT": 1,
+		"SOURCE_SEGWIT_ACCOUNT":  2,
+	}
+)
+
+func (x UTXOSource) Enum() *UTXOSource {
+	p := new(UTXOSource)
+	*p = x
+	return p
+}
+
+func (x UTXOSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UTXOSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_ipc_proto_enumTypes[3].Descriptor()
+}
+
+func (UTXOSource) Type() protoreflect.EnumType {
+	return &file_ipc_proto_enumTypes[3]
+}
+
+func (x UTXOSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UTXOSource.Descriptor instead.
+func (UTXOSource) EnumDescriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{3}
+}
+
+type OutgoingTxState int32
+
+const (
+	OutgoingTxState_TX_CREATED   OutgoingTxState = 0
+	OutgoingTxState_TX_PENDING   OutgoingTxState = 1
+	OutgoingTxState_TX_CONFIRMED OutgoingTxState = 2
+	OutgoingTxState_TX_DROPPED   OutgoingTxState = 3 // not confirmed within the drop timeout, not rebroadcast anymore
+	OutgoingTxState_TX_ABANDONED OutgoingTxState = 4
+)
+
+// Enum value maps for OutgoingTxState.
+var (
+	OutgoingTxState_name = map[int32]string{
+		0: "TX_CREATED",
+		1: "TX_PENDING",
+		2: "TX_CONFIRMED",
+		3: "TX_DROPPED",
+		4: "TX_ABANDONED",
+	}
+	OutgoingTxState_value = map[string]int32{
+		"TX_CREATED":   0,
+		"TX_PENDING":   1,
+		"TX_CONFIRMED": 2,
+		"TX_DROPPED":   3,
+		"TX_ABANDONED": 4,
+	}
+)
+
+func (x OutgoingTxState) Enum() *OutgoingTxState {
+	p := new(OutgoingTxState)
+	*p = x
+	return p
+}
+
+func (x OutgoingTxState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutgoingTxState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ipc_proto_enumTypes[4].Descriptor()
+}
+
+func (OutgoingTxState) Type() protoreflect.EnumType {
+	return &file_ipc_proto_enumTypes[4]
+}
+
+func (x OutgoingTxState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutgoingTxState.Descriptor instead.
+func (OutgoingTxState) EnumDescriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{4}
 }
 
 type ChainEnum int32
@@ -176,11 +329,11 @@ func (x ChainEnum) String() string {
 }
 
 func (ChainEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_ipc_proto_enumTypes[2].Descriptor()
+	return file_ipc_proto_enumTypes[5].Descriptor()
 }
 
 func (ChainEnum) Type() protoreflect.EnumType {
-	return &file_ipc_proto_enumTypes[2]
+	return &file_ipc_proto_enumTypes[5]
 }
 
 func (x ChainEnum) Number() protoreflect.EnumNumber {
@@ -189,7 +342,7 @@ func (x ChainEnum) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChainEnum.Descriptor instead.
 func (ChainEnum) EnumDescriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{2}
+	return file_ipc_proto_rawDescGZIP(), []int{5}
 }
 
 type Chain struct {
@@ -284,7 +437,8 @@ type StatusResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=ipc.Status" json:"status,omitempty"`
+	Status   Status          `protobuf:"varint,1,opt,name=status,proto3,enum=ipc.Status" json:"status,omitempty"`
+	Electrum *ElectrumStatus `protobuf:"bytes,2,opt,name=electrum,proto3" json:"electrum,omitempty"` // not set if Electrum is not used
 }
 
 func (x *StatusResponse) Reset() {
@@ -326,6 +480,92 @@ func (x *StatusResponse) GetStatus() Status {
 	return Status_STATUS_UNSPECIFIED
 }
 
+func (x *StatusResponse) GetElectrum() *ElectrumStatus {
+	if x != nil {
+		return x.Electrum
+	}
+	return nil
+}
+
+type ElectrumStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State          ElectrumConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=ipc.ElectrumConnectionState" json:"state,omitempty"`
+	Server         string                  `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"` // the connected server or the last one tried
+	LastError      string                  `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	ConnectedSince *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=connected_since,json=connectedSince,proto3" json:"connected_since,omitempty"`
+	Reconnects     uint32                  `protobuf:"varint,5,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+}
+
+func (x *ElectrumStatus) Reset() {
+	*x = ElectrumStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectrumStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectrumStatus) ProtoMessage() {}
+
+func (x *ElectrumStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectrumStatus.ProtoReflect.Descriptor instead.
+func (*ElectrumStatus) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ElectrumStatus) GetState() ElectrumConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ElectrumConnectionState_ELECTRUM_DISCONNECTED
+}
+
+func (x *ElectrumStatus) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ElectrumStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ElectrumStatus) GetConnectedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedSince
+	}
+	return nil
+}
+
+func (x *ElectrumStatus) GetReconnects() uint32 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
 type UTXOCollection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -337,7 +577,7 @@ type UTXOCollection struct {
 func (x *UTXOCollection) Reset() {
 	*x = UTXOCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[3]
+		mi := &file_ipc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -350,7 +590,7 @@ func (x *UTXOCollection) String() string {
 func (*UTXOCollection) ProtoMessage() {}
 
 func (x *UTXOCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[3]
+	mi := &file_ipc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +603,7 @@ func (x *UTXOCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UTXOCollection.ProtoReflect.Descriptor instead.
 func (*UTXOCollection) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{3}
+	return file_ipc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UTXOCollection) GetUtxos() []*OwnedUTXO {
@@ -384,7 +624,7 @@ type PasswordRequest struct {
 func (x *PasswordRequest) Reset() {
 	*x = PasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[4]
+		mi := &file_ipc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -397,7 +637,7 @@ func (x *PasswordRequest) String() string {
 func (*PasswordRequest) ProtoMessage() {}
 
 func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[4]
+	mi := &file_ipc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +650,7 @@ func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PasswordRequest.ProtoReflect.Descriptor instead.
 func (*PasswordRequest) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{4}
+	return file_ipc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PasswordRequest) GetPassword() string {
@@ -433,7 +673,7 @@ type BoolResponse struct {
 func (x *BoolResponse) Reset() {
 	*x = BoolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[5]
+		mi := &file_ipc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -446,7 +686,7 @@ func (x *BoolResponse) String() string {
 func (*BoolResponse) ProtoMessage() {}
 
 func (x *BoolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[5]
+	mi := &file_ipc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +699,7 @@ func (x *BoolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolResponse.ProtoReflect.Descriptor instead.
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{5}
+	return file_ipc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BoolResponse) GetSuccess() bool {
@@ -488,12 +728,13 @@ type OwnedUTXO struct {
 	TimestampConfirmed *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp_confirmed,json=timestampConfirmed,proto3" json:"timestamp_confirmed,omitempty"`
 	UtxoState          UTXOState              `protobuf:"varint,6,opt,name=utxo_state,json=utxoState,proto3,enum=ipc.UTXOState" json:"utxo_state,omitempty"`
 	Label              *Label                 `protobuf:"bytes,7,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Source             UTXOSource             `protobuf:"varint,8,opt,name=source,proto3,enum=ipc.UTXOSource" json:"source,omitempty"` // how the UTXO was received
 }
 
 func (x *OwnedUTXO) Reset() {
 	*x = OwnedUTXO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[6]
+		mi := &file_ipc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +747,7 @@ func (x *OwnedUTXO) String() string {
 func (*OwnedUTXO) ProtoMessage() {}
 
 func (x *OwnedUTXO) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[6]
+	mi := &file_ipc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +760,7 @@ func (x *OwnedUTXO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OwnedUTXO.ProtoReflect.Descriptor instead.
 func (*OwnedUTXO) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{6}
+	return file_ipc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OwnedUTXO) GetTxid() []byte {
@@ -571,6 +812,13 @@ func (x *OwnedUTXO) GetLabel() *Label {
 	return nil
 }
 
+func (x *OwnedUTXO) GetSource() UTXOSource {
+	if x != nil {
+		return x.Source
+	}
+	return UTXOSource_SOURCE_SILENT_PAYMENT
+}
+
 type Label struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -584,7 +832,7 @@ type Label struct {
 func (x *Label) Reset() {
 	*x = Label{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[7]
+		mi := &file_ipc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +845,7 @@ func (x *Label) String() string {
 func (*Label) ProtoMessage() {}
 
 func (x *Label) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[7]
+	mi := &file_ipc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +858,7 @@ func (x *Label) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Label.ProtoReflect.Descriptor instead.
 func (*Label) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{7}
+	return file_ipc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Label) GetM() uint32 {
@@ -645,7 +893,7 @@ type LabelsCollection struct {
 func (x *LabelsCollection) Reset() {
 	*x = LabelsCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[8]
+		mi := &file_ipc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +906,7 @@ func (x *LabelsCollection) String() string {
 func (*LabelsCollection) ProtoMessage() {}
 
 func (x *LabelsCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[8]
+	mi := &file_ipc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +919,7 @@ func (x *LabelsCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LabelsCollection.ProtoReflect.Descriptor instead.
 func (*LabelsCollection) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{8}
+	return file_ipc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LabelsCollection) GetLabels() []*Label {
@@ -687,15 +935,18 @@ type CreateTransactionRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Recipients          []*TransactionRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	FeeRate             int64                   `protobuf:"varint,2,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	FeeRate             int64                   `protobuf:"varint,2,opt,name=feeRate,proto3" json:"feeRate,omitempty"` // fee rate in sats/vByte
 	MarkSpent           bool                    `protobuf:"varint,3,opt,name=markSpent,proto3" json:"markSpent,omitempty"`
 	UseSpentUnconfirmed bool                    `protobuf:"varint,4,opt,name=useSpentUnconfirmed,proto3" json:"useSpentUnconfirmed,omitempty"`
+	FeeRateMilliSats    uint64                  `protobuf:"varint,5,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"` // fee rate in millisats/vByte (1500 = 1.5 sats/vByte), takes precedence over feeRate
+	AbsoluteFee         uint64                  `protobuf:"varint,6,opt,name=absoluteFee,proto3" json:"absoluteFee,omitempty"`           // total fee in sats, takes precedence over any fee rate
+	ConfTarget          uint32                  `protobuf:"varint,7,opt,name=confTarget,proto3" json:"confTarget,omitempty"`             // confirmation target in blocks, the fee rate is estimated by the daemon if no other fee is set
 }
 
 func (x *CreateTransactionRequest) Reset() {
 	*x = CreateTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[9]
+		mi := &file_ipc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -708,7 +959,7 @@ func (x *CreateTransactionRequest) String() string {
 func (*CreateTransactionRequest) ProtoMessage() {}
 
 func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[9]
+	mi := &file_ipc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +972,7 @@ func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{9}
+	return file_ipc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateTransactionRequest) GetRecipients() []*TransactionRecipient {
@@ -752,20 +1003,41 @@ func (x *CreateTransactionRequest) GetUseSpentUnconfirmed() bool {
 	return false
 }
 
+func (x *CreateTransactionRequest) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetAbsoluteFee() uint64 {
+	if x != nil {
+		return x.AbsoluteFee
+	}
+	return 0
+}
+
+func (x *CreateTransactionRequest) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
 type TransactionRecipient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // address or BIP 21 URI (bitcoin:...), the sp parameter of a URI is preferred over the fallback address
+	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`  // can be 0 if the URI has an amount
 	Annotation string `protobuf:"bytes,3,opt,name=annotation,proto3" json:"annotation,omitempty"`
 }
 
 func (x *TransactionRecipient) Reset() {
 	*x = TransactionRecipient{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[10]
+		mi := &file_ipc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -778,7 +1050,7 @@ func (x *TransactionRecipient) String() string {
 func (*TransactionRecipient) ProtoMessage() {}
 
 func (x *TransactionRecipient) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[10]
+	mi := &file_ipc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +1063,7 @@ func (x *TransactionRecipient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionRecipient.ProtoReflect.Descriptor instead.
 func (*TransactionRecipient) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{10}
+	return file_ipc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TransactionRecipient) GetAddress() string {
@@ -826,7 +1098,7 @@ type RawTransaction struct {
 func (x *RawTransaction) Reset() {
 	*x = RawTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[11]
+		mi := &file_ipc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -839,7 +1111,7 @@ func (x *RawTransaction) String() string {
 func (*RawTransaction) ProtoMessage() {}
 
 func (x *RawTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[11]
+	mi := &file_ipc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1124,7 @@ func (x *RawTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawTransaction.ProtoReflect.Descriptor instead.
 func (*RawTransaction) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{11}
+	return file_ipc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RawTransaction) GetRawTx() []byte {
@@ -873,7 +1145,7 @@ type NewTransaction struct {
 func (x *NewTransaction) Reset() {
 	*x = NewTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[12]
+		mi := &file_ipc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -886,7 +1158,7 @@ func (x *NewTransaction) String() string {
 func (*NewTransaction) ProtoMessage() {}
 
 func (x *NewTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[12]
+	mi := &file_ipc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1171,7 @@ func (x *NewTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewTransaction.ProtoReflect.Descriptor instead.
 func (*NewTransaction) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{12}
+	return file_ipc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *NewTransaction) GetTxid() string {
@@ -920,7 +1192,7 @@ type AddressesCollection struct {
 func (x *AddressesCollection) Reset() {
 	*x = AddressesCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[13]
+		mi := &file_ipc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -933,7 +1205,7 @@ func (x *AddressesCollection) String() string {
 func (*AddressesCollection) ProtoMessage() {}
 
 func (x *AddressesCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[13]
+	mi := &file_ipc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1218,7 @@ func (x *AddressesCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressesCollection.ProtoReflect.Descriptor instead.
 func (*AddressesCollection) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{13}
+	return file_ipc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddressesCollection) GetAddresses() []*Address {
@@ -968,7 +1240,7 @@ type Address struct {
 func (x *Address) Reset() {
 	*x = Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[14]
+		mi := &file_ipc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -981,7 +1253,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[14]
+	mi := &file_ipc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1266,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{14}
+	return file_ipc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Address) GetAddress() string {
@@ -1022,7 +1294,7 @@ type NewLabelRequest struct {
 func (x *NewLabelRequest) Reset() {
 	*x = NewLabelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[15]
+		mi := &file_ipc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1035,7 +1307,7 @@ func (x *NewLabelRequest) String() string {
 func (*NewLabelRequest) ProtoMessage() {}
 
 func (x *NewLabelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[15]
+	mi := &file_ipc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +1320,7 @@ func (x *NewLabelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewLabelRequest.ProtoReflect.Descriptor instead.
 func (*NewLabelRequest) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{15}
+	return file_ipc_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *NewLabelRequest) GetComment() string {
@@ -1069,7 +1341,7 @@ type SyncHeightResponse struct {
 func (x *SyncHeightResponse) Reset() {
 	*x = SyncHeightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[16]
+		mi := &file_ipc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1082,7 +1354,7 @@ func (x *SyncHeightResponse) String() string {
 func (*SyncHeightResponse) ProtoMessage() {}
 
 func (x *SyncHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[16]
+	mi := &file_ipc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1367,7 @@ func (x *SyncHeightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncHeightResponse.ProtoReflect.Descriptor instead.
 func (*SyncHeightResponse) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{16}
+	return file_ipc_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SyncHeightResponse) GetHeight() uint64 {
@@ -1116,7 +1388,7 @@ type Mnemonic struct {
 func (x *Mnemonic) Reset() {
 	*x = Mnemonic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[17]
+		mi := &file_ipc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1129,7 +1401,7 @@ func (x *Mnemonic) String() string {
 func (*Mnemonic) ProtoMessage() {}
 
 func (x *Mnemonic) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[17]
+	mi := &file_ipc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1414,7 @@ func (x *Mnemonic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mnemonic.ProtoReflect.Descriptor instead.
 func (*Mnemonic) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{17}
+	return file_ipc_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Mnemonic) GetMnemonic() string {
@@ -1164,7 +1436,7 @@ type NewWalletRequest struct {
 func (x *NewWalletRequest) Reset() {
 	*x = NewWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[18]
+		mi := &file_ipc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1177,7 +1449,7 @@ func (x *NewWalletRequest) String() string {
 func (*NewWalletRequest) ProtoMessage() {}
 
 func (x *NewWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[18]
+	mi := &file_ipc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1462,7 @@ func (x *NewWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewWalletRequest.ProtoReflect.Descriptor instead.
 func (*NewWalletRequest) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{18}
+	return file_ipc_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *NewWalletRequest) GetEncryptionPassword() string {
@@ -1222,7 +1494,7 @@ type RecoverWalletRequest struct {
 func (x *RecoverWalletRequest) Reset() {
 	*x = RecoverWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[19]
+		mi := &file_ipc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1235,7 +1507,7 @@ func (x *RecoverWalletRequest) String() string {
 func (*RecoverWalletRequest) ProtoMessage() {}
 
 func (x *RecoverWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[19]
+	mi := &file_ipc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1520,7 @@ func (x *RecoverWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecoverWalletRequest.ProtoReflect.Descriptor instead.
 func (*RecoverWalletRequest) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{19}
+	return file_ipc_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RecoverWalletRequest) GetEncryptionPassword() string {
@@ -1262,55 +1534,1326 @@ func (x *RecoverWalletRequest) GetMnemonic() string {
 	if x != nil {
 		return x.Mnemonic
 	}
-	return ""
+	return ""
+}
+
+func (x *RecoverWalletRequest) GetBirthHeight() uint64 {
+	if x != nil {
+		return x.BirthHeight
+	}
+	return 0
+}
+
+func (x *RecoverWalletRequest) GetLabelCount() uint32 {
+	if x != nil {
+		return x.LabelCount
+	}
+	return 0
+}
+
+func (x *RecoverWalletRequest) GetSeedPassphrase() string {
+	if x != nil && x.SeedPassphrase != nil {
+		return *x.SeedPassphrase
+	}
+	return ""
+}
+
+type RescanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *RescanRequest) Reset() {
+	*x = RescanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanRequest) ProtoMessage() {}
+
+func (x *RescanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanRequest.ProtoReflect.Descriptor instead.
+func (*RescanRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RescanRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type EstimateFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfTarget uint32 `protobuf:"varint,1,opt,name=confTarget,proto3" json:"confTarget,omitempty"` // confirmation target in blocks
+}
+
+func (x *EstimateFeeRequest) Reset() {
+	*x = EstimateFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFeeRequest) ProtoMessage() {}
+
+func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EstimateFeeRequest) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+type FeeEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfTarget       uint32                 `protobuf:"varint,1,opt,name=confTarget,proto3" json:"confTarget,omitempty"`
+	FeeRateMilliSats uint64                 `protobuf:"varint,2,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"` // fee rate in millisats/vByte
+	Source           string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                      // electrum or indexer
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                // when the estimate was fetched from the source
+}
+
+func (x *FeeEstimate) Reset() {
+	*x = FeeEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeEstimate) ProtoMessage() {}
+
+func (x *FeeEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeEstimate.ProtoReflect.Descriptor instead.
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FeeEstimate) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *FeeEstimate) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *FeeEstimate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FeeEstimate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type BumpFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid             string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`                          // txid of the transaction that should be replaced
+	FeeRateMilliSats uint64 `protobuf:"varint,2,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"` // new fee rate in millisats/vByte
+	Broadcast        bool   `protobuf:"varint,3,opt,name=broadcast,proto3" json:"broadcast,omitempty"`               // broadcast the replacement directly
+}
+
+func (x *BumpFeeRequest) Reset() {
+	*x = BumpFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeRequest) ProtoMessage() {}
+
+func (x *BumpFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BumpFeeRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *BumpFeeRequest) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *BumpFeeRequest) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+type BumpFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid  string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	RawTx []byte `protobuf:"bytes,2,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Fee   uint64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"` // absolute fee of the replacement in sats
+}
+
+func (x *BumpFeeResponse) Reset() {
+	*x = BumpFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeResponse) ProtoMessage() {}
+
+func (x *BumpFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BumpFeeResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *BumpFeeResponse) GetRawTx() []byte {
+	if x != nil {
+		return x.RawTx
+	}
+	return nil
+}
+
+func (x *BumpFeeResponse) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+type TransactionPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inputs           []*OwnedUTXO     `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs          []*PreviewOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`                    // in the order of the final transaction
+	VsizeEstimate    float64          `protobuf:"fixed64,3,opt,name=vsizeEstimate,proto3" json:"vsizeEstimate,omitempty"`      // size in vBytes as estimated by the coin selector
+	Fee              uint64           `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                           // absolute fee in sats
+	FeeRateMilliSats uint64           `protobuf:"varint,5,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"` // effective fee rate in millisats/vByte
+	MarkedSpent      []*OwnedUTXO     `protobuf:"bytes,6,rep,name=markedSpent,proto3" json:"markedSpent,omitempty"`            // UTXOs which would become spent_unconfirmed
+}
+
+func (x *TransactionPreview) Reset() {
+	*x = TransactionPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionPreview) ProtoMessage() {}
+
+func (x *TransactionPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionPreview.ProtoReflect.Descriptor instead.
+func (*TransactionPreview) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TransactionPreview) GetInputs() []*OwnedUTXO {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *TransactionPreview) GetOutputs() []*PreviewOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *TransactionPreview) GetVsizeEstimate() float64 {
+	if x != nil {
+		return x.VsizeEstimate
+	}
+	return 0
+}
+
+func (x *TransactionPreview) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TransactionPreview) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *TransactionPreview) GetMarkedSpent() []*OwnedUTXO {
+	if x != nil {
+		return x.MarkedSpent
+	}
+	return nil
+}
+
+type PreviewOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PkScript []byte `protobuf:"bytes,2,opt,name=pkScript,proto3" json:"pkScript,omitempty"`
+	Amount   uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	IsChange bool   `protobuf:"varint,4,opt,name=isChange,proto3" json:"isChange,omitempty"`
+}
+
+func (x *PreviewOutput) Reset() {
+	*x = PreviewOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewOutput) ProtoMessage() {}
+
+func (x *PreviewOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewOutput.ProtoReflect.Descriptor instead.
+func (*PreviewOutput) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PreviewOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PreviewOutput) GetPkScript() []byte {
+	if x != nil {
+		return x.PkScript
+	}
+	return nil
+}
+
+func (x *PreviewOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PreviewOutput) GetIsChange() bool {
+	if x != nil {
+		return x.IsChange
+	}
+	return false
+}
+
+type ImportUTXORequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+}
+
+func (x *ImportUTXORequest) Reset() {
+	*x = ImportUTXORequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUTXORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUTXORequest) ProtoMessage() {}
+
+func (x *ImportUTXORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUTXORequest.ProtoReflect.Descriptor instead.
+func (*ImportUTXORequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportUTXORequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *ImportUTXORequest) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+type CpfpBumpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid             string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`                          // txid of the unconfirmed transaction which pays to this wallet
+	FeeRateMilliSats uint64 `protobuf:"varint,2,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"` // target fee rate for parent and child together in millisats/vByte
+	Broadcast        bool   `protobuf:"varint,3,opt,name=broadcast,proto3" json:"broadcast,omitempty"`               // broadcast the child directly
+}
+
+func (x *CpfpBumpRequest) Reset() {
+	*x = CpfpBumpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpfpBumpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpfpBumpRequest) ProtoMessage() {}
+
+func (x *CpfpBumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpfpBumpRequest.ProtoReflect.Descriptor instead.
+func (*CpfpBumpRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CpfpBumpRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *CpfpBumpRequest) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *CpfpBumpRequest) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // set by the daemon
+	Recipients          []*TransactionRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Comment             string                  `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	StartHeight         uint64                  `protobuf:"varint,4,opt,name=startHeight,proto3" json:"startHeight,omitempty"`                  // first execution at this block height, either startHeight or startTime has to be set
+	StartTime           *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,omitempty"`                       // first execution at this time
+	IntervalBlocks      uint64                  `protobuf:"varint,6,opt,name=intervalBlocks,proto3" json:"intervalBlocks,omitempty"`            // repeat every n blocks, only with startHeight
+	IntervalSeconds     uint64                  `protobuf:"varint,7,opt,name=intervalSeconds,proto3" json:"intervalSeconds,omitempty"`          // repeat every n seconds, only with startTime
+	IntervalMonths      uint32                  `protobuf:"varint,8,opt,name=intervalMonths,proto3" json:"intervalMonths,omitempty"`            // repeat every n calendar months, only with startTime
+	FeeRateMilliSats    uint64                  `protobuf:"varint,9,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"`        // fixed fee rate in millisats/vByte
+	ConfTarget          uint32                  `protobuf:"varint,10,opt,name=confTarget,proto3" json:"confTarget,omitempty"`                   // estimate the fee rate at execution if no fixed fee rate is set
+	MaxFeeRateMilliSats uint64                  `protobuf:"varint,11,opt,name=maxFeeRateMilliSats,proto3" json:"maxFeeRateMilliSats,omitempty"` // executions above this fee rate are skipped and retried with the next block, 0 = no cap
+	MaxFee              uint64                  `protobuf:"varint,12,opt,name=maxFee,proto3" json:"maxFee,omitempty"`                           // executions above this absolute fee in sats are skipped and retried with the next block, 0 = no cap
+	Active              bool                    `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`                           // inactive after a one-off schedule was executed
+	NextHeight          uint64                  `protobuf:"varint,14,opt,name=nextHeight,proto3" json:"nextHeight,omitempty"`
+	NextTime            *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=nextTime,proto3" json:"nextTime,omitempty"`
+	Executions          []*ScheduleExecution    `protobuf:"bytes,16,rep,name=executions,proto3" json:"executions,omitempty"` // audit log of all executions and failures
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Schedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Schedule) GetRecipients() []*TransactionRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *Schedule) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Schedule) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *Schedule) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetIntervalBlocks() uint64 {
+	if x != nil {
+		return x.IntervalBlocks
+	}
+	return 0
+}
+
+func (x *Schedule) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Schedule) GetIntervalMonths() uint32 {
+	if x != nil {
+		return x.IntervalMonths
+	}
+	return 0
+}
+
+func (x *Schedule) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *Schedule) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *Schedule) GetMaxFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.MaxFeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *Schedule) GetMaxFee() uint64 {
+	if x != nil {
+		return x.MaxFee
+	}
+	return 0
+}
+
+func (x *Schedule) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Schedule) GetNextHeight() uint64 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
+func (x *Schedule) GetNextTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetExecutions() []*ScheduleExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type ScheduleExecution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height           uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // sync height of the wallet at execution
+	Txid             string                 `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`      // empty if the execution failed
+	Fee              uint64                 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeRateMilliSats uint64                 `protobuf:"varint,5,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"`
+	Error            string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // only set if the execution failed
+}
+
+func (x *ScheduleExecution) Reset() {
+	*x = ScheduleExecution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleExecution) ProtoMessage() {}
+
+func (x *ScheduleExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleExecution.ProtoReflect.Descriptor instead.
+func (*ScheduleExecution) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduleExecution) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ScheduleExecution) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ScheduleExecution) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *ScheduleExecution) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *ScheduleExecution) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *ScheduleExecution) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SchedulesCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *SchedulesCollection) Reset() {
+	*x = SchedulesCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulesCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulesCollection) ProtoMessage() {}
+
+func (x *SchedulesCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulesCollection.ProtoReflect.Descriptor instead.
+func (*SchedulesCollection) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SchedulesCollection) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ValidateAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ValidateAddressRequest) Reset() {
+	*x = ValidateAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAddressRequest) ProtoMessage() {}
+
+func (x *ValidateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAddressRequest.ProtoReflect.Descriptor instead.
+func (*ValidateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ValidateAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AddressInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address            string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	IsValid            bool    `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	Error              string  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // reason why the address is not valid
+	IsSilentPayment    bool    `protobuf:"varint,4,opt,name=isSilentPayment,proto3" json:"isSilentPayment,omitempty"`
+	Network            string  `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"` // network the address belongs to, "testnet" for all SP test networks
+	IsForWalletNetwork bool    `protobuf:"varint,6,opt,name=isForWalletNetwork,proto3" json:"isForWalletNetwork,omitempty"`
+	Version            uint32  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"` // silent payment version
+	ScanPubKey         []byte  `protobuf:"bytes,8,opt,name=scanPubKey,proto3" json:"scanPubKey,omitempty"`
+	SpendPubKey        []byte  `protobuf:"bytes,9,opt,name=spendPubKey,proto3" json:"spendPubKey,omitempty"`
+	LabelM             *uint32 `protobuf:"varint,10,opt,name=labelM,proto3,oneof" json:"labelM,omitempty"`  // only set for labelled SP addresses of this wallet
+	ScriptType         string  `protobuf:"bytes,11,opt,name=scriptType,proto3" json:"scriptType,omitempty"` // script type of regular addresses
+	PkScript           []byte  `protobuf:"bytes,12,opt,name=pkScript,proto3" json:"pkScript,omitempty"`     // script of regular addresses
+	IsMine             bool    `protobuf:"varint,13,opt,name=isMine,proto3" json:"isMine,omitempty"`
+	Comment            string  `protobuf:"bytes,14,opt,name=comment,proto3" json:"comment,omitempty"` // label or address comment if the address belongs to this wallet
+}
+
+func (x *AddressInfo) Reset() {
+	*x = AddressInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressInfo) ProtoMessage() {}
+
+func (x *AddressInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressInfo.ProtoReflect.Descriptor instead.
+func (*AddressInfo) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddressInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *AddressInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetIsSilentPayment() bool {
+	if x != nil {
+		return x.IsSilentPayment
+	}
+	return false
+}
+
+func (x *AddressInfo) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetIsForWalletNetwork() bool {
+	if x != nil {
+		return x.IsForWalletNetwork
+	}
+	return false
+}
+
+func (x *AddressInfo) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *AddressInfo) GetScanPubKey() []byte {
+	if x != nil {
+		return x.ScanPubKey
+	}
+	return nil
+}
+
+func (x *AddressInfo) GetSpendPubKey() []byte {
+	if x != nil {
+		return x.SpendPubKey
+	}
+	return nil
+}
+
+func (x *AddressInfo) GetLabelM() uint32 {
+	if x != nil && x.LabelM != nil {
+		return *x.LabelM
+	}
+	return 0
+}
+
+func (x *AddressInfo) GetScriptType() string {
+	if x != nil {
+		return x.ScriptType
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetPkScript() []byte {
+	if x != nil {
+		return x.PkScript
+	}
+	return nil
+}
+
+func (x *AddressInfo) GetIsMine() bool {
+	if x != nil {
+		return x.IsMine
+	}
+	return false
+}
+
+func (x *AddressInfo) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type OutgoingTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid             string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	State            OutgoingTxState        `protobuf:"varint,2,opt,name=state,proto3,enum=ipc.OutgoingTxState" json:"state,omitempty"`
+	Fee              uint64                 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"` // absolute fee in sats, 0 if the transaction was signed elsewhere
+	FeeRateMilliSats uint64                 `protobuf:"varint,4,opt,name=feeRateMilliSats,proto3" json:"feeRateMilliSats,omitempty"`
+	Amount           uint64                 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"` // sum of all recipients without change
+	Created          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	FirstBroadcast   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=firstBroadcast,proto3" json:"firstBroadcast,omitempty"`
+	LastBroadcast    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=lastBroadcast,proto3" json:"lastBroadcast,omitempty"`
+	External         bool                   `protobuf:"varint,9,opt,name=external,proto3" json:"external,omitempty"` // signed elsewhere and broadcast as raw transaction
+}
+
+func (x *OutgoingTransaction) Reset() {
+	*x = OutgoingTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutgoingTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutgoingTransaction) ProtoMessage() {}
+
+func (x *OutgoingTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutgoingTransaction.ProtoReflect.Descriptor instead.
+func (*OutgoingTransaction) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OutgoingTransaction) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *OutgoingTransaction) GetState() OutgoingTxState {
+	if x != nil {
+		return x.State
+	}
+	return OutgoingTxState_TX_CREATED
+}
+
+func (x *OutgoingTransaction) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *OutgoingTransaction) GetFeeRateMilliSats() uint64 {
+	if x != nil {
+		return x.FeeRateMilliSats
+	}
+	return 0
+}
+
+func (x *OutgoingTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OutgoingTransaction) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *OutgoingTransaction) GetFirstBroadcast() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstBroadcast
+	}
+	return nil
+}
+
+func (x *OutgoingTransaction) GetLastBroadcast() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastBroadcast
+	}
+	return nil
+}
+
+func (x *OutgoingTransaction) GetExternal() bool {
+	if x != nil {
+		return x.External
+	}
+	return false
+}
+
+type OutgoingTransactionsCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*OutgoingTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *OutgoingTransactionsCollection) Reset() {
+	*x = OutgoingTransactionsCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ipc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutgoingTransactionsCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutgoingTransactionsCollection) ProtoMessage() {}
+
+func (x *OutgoingTransactionsCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *RecoverWalletRequest) GetBirthHeight() uint64 {
-	if x != nil {
-		return x.BirthHeight
-	}
-	return 0
+// Deprecated: Use OutgoingTransactionsCollection.ProtoReflect.Descriptor instead.
+func (*OutgoingTransactionsCollection) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RecoverWalletRequest) GetLabelCount() uint32 {
+func (x *OutgoingTransactionsCollection) GetTransactions() []*OutgoingTransaction {
 	if x != nil {
-		return x.LabelCount
-	}
-	return 0
-}
-
-func (x *RecoverWalletRequest) GetSeedPassphrase() string {
-	if x != nil && x.SeedPassphrase != nil {
-		return *x.SeedPassphrase
+		return x.Transactions
 	}
-	return ""
+	return nil
 }
 
-type RescanRequest struct {
+type AbandonTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (x *RescanRequest) Reset() {
-	*x = RescanRequest{}
+func (x *AbandonTransactionRequest) Reset() {
+	*x = AbandonTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ipc_proto_msgTypes[20]
+		mi := &file_ipc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RescanRequest) String() string {
+func (x *AbandonTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RescanRequest) ProtoMessage() {}
+func (*AbandonTransactionRequest) ProtoMessage() {}
 
-func (x *RescanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ipc_proto_msgTypes[20]
+func (x *AbandonTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ipc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,16 +2864,16 @@ func (x *RescanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RescanRequest.ProtoReflect.Descriptor instead.
-func (*RescanRequest) Descriptor() ([]byte, []int) {
-	return file_ipc_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AbandonTransactionRequest.ProtoReflect.Descriptor instead.
+func (*AbandonTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ipc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RescanRequest) GetHeight() int64 {
+func (x *AbandonTransactionRequest) GetTxid() string {
 	if x != nil {
-		return x.Height
+		return x.Txid
 	}
-	return 0
+	return ""
 }
 
 var File_ipc_proto protoreflect.FileDescriptor
@@ -1342,194 +2885,475 @@ var file_ipc_proto_rawDesc = []byte{
 	0x6f, 0x22, 0x2d, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e,
 	0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x66, 0x0a, 0x0e, 0x53, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x69, 0x70,
 	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x36, 0x0a, 0x0e, 0x55, 0x54, 0x58, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x55, 0x54, 0x58,
-	0x4f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x2d, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3e, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x09, 0x4f, 0x77, 0x6e, 0x65,
-	0x64, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x4b,
-	0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x0a, 0x75,
-	0x74, 0x78, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x55, 0x54, 0x58, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x09, 0x75, 0x74, 0x78, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88, 0x01,
-	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x49, 0x0a, 0x05, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x01, 0x4d, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x10, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x69, 0x70, 0x63,
-	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xbf,
-	0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x30,
-	0x0a, 0x13, 0x75, 0x73, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x75, 0x73, 0x65,
-	0x53, 0x70, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
-	0x22, 0x68, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x61,
-	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06,
-	0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61,
-	0x77, 0x54, 0x78, 0x22, 0x24, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2a, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x07,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x4e,
-	0x65, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x26, 0x0a, 0x08, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x6a,
-	0x0a, 0x10, 0x4e, 0x65, 0x77, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68,
-	0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x65, 0x64,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x14, 0x52,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x2f, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x75,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x75,
+	0x6d, 0x22, 0xe0, 0x01, 0x0a, 0x0e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x75, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x72,
+	0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x43, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0e, 0x55, 0x54, 0x58, 0x4f, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f, 0x77, 0x6e, 0x65,
+	0x64, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x2d, 0x0a, 0x0f,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3e, 0x0a, 0x0c, 0x42,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xba, 0x02, 0x0a, 0x09,
+	0x4f, 0x77, 0x6e, 0x65, 0x64, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x6f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x12, 0x4b, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12,
+	0x2d, 0x0a, 0x0a, 0x75, 0x74, 0x78, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x55, 0x54, 0x58, 0x4f, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x09, 0x75, 0x74, 0x78, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x55, 0x54, 0x58, 0x4f,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x49, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x4d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x4d, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x10, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xad, 0x02, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x75,
+	0x73, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x75, 0x73, 0x65, 0x53, 0x70, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x65, 0x46, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x68, 0x0a, 0x14, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x0e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x24,
+	0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x26, 0x0a, 0x08, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x6a, 0x0a, 0x10, 0x4e, 0x65, 0x77,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
 	0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x69, 0x72, 0x74, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x69, 0x72, 0x74, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2b, 0x0a, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x65,
-	0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11,
-	0x0a, 0x0f, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0xa1, 0x01, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
-	0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45,
-	0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
-	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x49,
-	0x4e, 0x47, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
-	0x48, 0x55, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x2a, 0x58,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69,
+	0x72, 0x74, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x69, 0x72, 0x74, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x0e,
+	0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73, 0x65,
+	0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x0b,
+	0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6e, 0x0a, 0x0e, 0x42, 0x75, 0x6d,
+	0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53,
+	0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x0f, 0x42, 0x75, 0x6d,
+	0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x26, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x55, 0x54, 0x58, 0x4f,
+	0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x73, 0x69, 0x7a, 0x65, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x76,
+	0x73, 0x69, 0x7a, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x64, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x55, 0x54, 0x58, 0x4f, 0x52,
+	0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x0d,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6b, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x6b, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x76, 0x6f, 0x75, 0x74, 0x22, 0x6f, 0x0a, 0x0f, 0x43, 0x70, 0x66, 0x70, 0x42, 0x75, 0x6d, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x66,
+	0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x83, 0x05, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x2a, 0x0a,
+	0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x61, 0x78,
+	0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6e,
+	0x65, 0x78, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x11,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x53, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x13, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbd, 0x03, 0x0a,
+	0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x53, 0x69, 0x6c, 0x65,
+	0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x73, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73,
+	0x46, 0x6f, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4d,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4d,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x73, 0x4d, 0x69, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x69, 0x73, 0x4d, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x22, 0x83, 0x03, 0x0a,
+	0x13, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x42, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x22, 0x5e, 0x0a, 0x1e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x19, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x2a, 0xa1, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x4e, 0x4f, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x2a, 0x65, 0x0a, 0x17, 0x45, 0x6c, 0x65, 0x63, 0x74,
+	0x72, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x52, 0x55, 0x4d, 0x5f, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x52, 0x55, 0x4d, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x52,
+	0x55, 0x4d, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x58,
 	0x0a, 0x09, 0x55, 0x54, 0x58, 0x4f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
 	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x43, 0x4f,
 	0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x53,
 	0x50, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x45, 0x4e, 0x54, 0x10,
 	0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x50, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x4b, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x69, 0x6e, 0x6e, 0x65, 0x74, 0x10, 0x01, 0x12,
-	0x0b, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x6e, 0x65, 0x74, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x74, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x65, 0x67, 0x74,
-	0x65, 0x73, 0x74, 0x10, 0x04, 0x32, 0xdd, 0x07, 0x0a, 0x0a, 0x49, 0x70, 0x63, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a,
-	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x0a, 0x2e,
-	0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x53, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x69,
-	0x70, 0x63, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
-	0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x54, 0x58, 0x4f, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x55, 0x54, 0x58, 0x4f, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
-	0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70,
-	0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
-	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a,
-	0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1d,
-	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52,
-	0x61, 0x77, 0x54, 0x78, 0x12, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x0a, 0x2e,
-	0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x2f, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4d, 0x6e,
-	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x69,
-	0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x12, 0x3d, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x2e, 0x69, 0x70, 0x63,
-	0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
-	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x0a, 0x2e,
-	0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x5e, 0x0a, 0x0a, 0x55, 0x54, 0x58, 0x4f,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x50, 0x52,
+	0x4f, 0x4f, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x47, 0x57, 0x49, 0x54, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0x65, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
+	0x58, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
+	0x58, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x54, 0x58, 0x5f, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0x4b, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x69,
+	0x6e, 0x6e, 0x65, 0x74, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x6e, 0x65,
+	0x74, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x74, 0x10, 0x03, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x65, 0x67, 0x74, 0x65, 0x73, 0x74, 0x10, 0x04, 0x32, 0xff, 0x0d, 0x0a,
+	0x0a, 0x49, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x17, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x73, 0x12, 0x0a, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x55,
+	0x54, 0x58, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x0a,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x65, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65,
+	0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0e, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x61, 0x77, 0x54, 0x78, 0x12, 0x13, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x6e, 0x65, 0x6d,
+	0x6f, 0x6e, 0x69, 0x63, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12,
+	0x2f, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x0d,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x1a, 0x11, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x3d, 0x0a, 0x0d, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x12, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x0b,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x17, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65,
+	0x65, 0x12, 0x13, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d,
+	0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08,
+	0x43, 0x70, 0x66, 0x70, 0x42, 0x75, 0x6d, 0x70, 0x12, 0x14, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x43,
+	0x70, 0x66, 0x70, 0x42, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x34, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x54, 0x58, 0x4f,
+	0x12, 0x16, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x54, 0x58,
+	0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f,
+	0x77, 0x6e, 0x65, 0x64, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x37, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x54,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x2e,
+	0x69, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x1a, 0x0d, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x35, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x69,
+	0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x69, 0x70, 0x63,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0a, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x23, 0x2e, 0x69, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x12, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x69, 0x70,
+	0x63, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06,
+	0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1544,86 +3368,152 @@ func file_ipc_proto_rawDescGZIP() []byte {
 	return file_ipc_proto_rawDescData
 }
 
-var file_ipc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_ipc_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_ipc_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_ipc_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_ipc_proto_goTypes = []interface{}{
-	(Status)(0),                      // 0: ipc.Status
-	(UTXOState)(0),                   // 1: ipc.UTXOState
-	(ChainEnum)(0),                   // 2: ipc.ChainEnum
-	(*Chain)(nil),                    // 3: ipc.Chain
-	(*Empty)(nil),                    // 4: ipc.Empty
-	(*StatusResponse)(nil),           // 5: ipc.StatusResponse
-	(*UTXOCollection)(nil),           // 6: ipc.UTXOCollection
-	(*PasswordRequest)(nil),          // 7: ipc.PasswordRequest
-	(*BoolResponse)(nil),             // 8: ipc.BoolResponse
-	(*OwnedUTXO)(nil),                // 9: ipc.OwnedUTXO
-	(*Label)(nil),                    // 10: ipc.Label
-	(*LabelsCollection)(nil),         // 11: ipc.LabelsCollection
-	(*CreateTransactionRequest)(nil), // 12: ipc.CreateTransactionRequest
-	(*TransactionRecipient)(nil),     // 13: ipc.TransactionRecipient
-	(*RawTransaction)(nil),           // 14: ipc.RawTransaction
-	(*NewTransaction)(nil),           // 15: ipc.NewTransaction
-	(*AddressesCollection)(nil),      // 16: ipc.AddressesCollection
-	(*Address)(nil),                  // 17: ipc.Address
-	(*NewLabelRequest)(nil),          // 18: ipc.NewLabelRequest
-	(*SyncHeightResponse)(nil),       // 19: ipc.SyncHeightResponse
-	(*Mnemonic)(nil),                 // 20: ipc.Mnemonic
-	(*NewWalletRequest)(nil),         // 21: ipc.NewWalletRequest
-	(*RecoverWalletRequest)(nil),     // 22: ipc.RecoverWalletRequest
-	(*RescanRequest)(nil),            // 23: ipc.RescanRequest
-	(*timestamppb.Timestamp)(nil),    // 24: google.protobuf.Timestamp
+	(Status)(0),                            // 0: ipc.Status
+	(ElectrumConnectionState)(0),           // 1: ipc.ElectrumConnectionState
+	(UTXOState)(0),                         // 2: ipc.UTXOState
+	(UTXOSource)(0),                        // 3: ipc.UTXOSource
+	(OutgoingTxState)(0),                   // 4: ipc.OutgoingTxState
+	(ChainEnum)(0),                         // 5: ipc.ChainEnum
+	(*Chain)(nil),                          // 6: ipc.Chain
+	(*Empty)(nil),                          // 7: ipc.Empty
+	(*StatusResponse)(nil),                 // 8: ipc.StatusResponse
+	(*ElectrumStatus)(nil),                 // 9: ipc.ElectrumStatus
+	(*UTXOCollection)(nil),                 // 10: ipc.UTXOCollection
+	(*PasswordRequest)(nil),                // 11: ipc.PasswordRequest
+	(*BoolResponse)(nil),                   // 12: ipc.BoolResponse
+	(*OwnedUTXO)(nil),                      // 13: ipc.OwnedUTXO
+	(*Label)(nil),                          // 14: ipc.Label
+	(*LabelsCollection)(nil),               // 15: ipc.LabelsCollection
+	(*CreateTransactionRequest)(nil),       // 16: ipc.CreateTransactionRequest
+	(*TransactionRecipient)(nil),           // 17: ipc.TransactionRecipient
+	(*RawTransaction)(nil),                 // 18: ipc.RawTransaction
+	(*NewTransaction)(nil),                 // 19: ipc.NewTransaction
+	(*AddressesCollection)(nil),            // 20: ipc.AddressesCollection
+	(*Address)(nil),                        // 21: ipc.Address
+	(*NewLabelRequest)(nil),                // 22: ipc.NewLabelRequest
+	(*SyncHeightResponse)(nil),             // 23: ipc.SyncHeightResponse
+	(*Mnemonic)(nil),                       // 24: ipc.Mnemonic
+	(*NewWalletRequest)(nil),               // 25: ipc.NewWalletRequest
+	(*RecoverWalletRequest)(nil),           // 26: ipc.RecoverWalletRequest
+	(*RescanRequest)(nil),                  // 27: ipc.RescanRequest
+	(*EstimateFeeRequest)(nil),             // 28: ipc.EstimateFeeRequest
+	(*FeeEstimate)(nil),                    // 29: ipc.FeeEstimate
+	(*BumpFeeRequest)(nil),                 // 30: ipc.BumpFeeRequest
+	(*BumpFeeResponse)(nil),                // 31: ipc.BumpFeeResponse
+	(*TransactionPreview)(nil),             // 32: ipc.TransactionPreview
+	(*PreviewOutput)(nil),                  // 33: ipc.PreviewOutput
+	(*ImportUTXORequest)(nil),              // 34: ipc.ImportUTXORequest
+	(*CpfpBumpRequest)(nil),                // 35: ipc.CpfpBumpRequest
+	(*Schedule)(nil),                       // 36: ipc.Schedule
+	(*ScheduleExecution)(nil),              // 37: ipc.ScheduleExecution
+	(*SchedulesCollection)(nil),            // 38: ipc.SchedulesCollection
+	(*DeleteScheduleRequest)(nil),          // 39: ipc.DeleteScheduleRequest
+	(*ValidateAddressRequest)(nil),         // 40: ipc.ValidateAddressRequest
+	(*AddressInfo)(nil),                    // 41: ipc.AddressInfo
+	(*OutgoingTransaction)(nil),            // 42: ipc.OutgoingTransaction
+	(*OutgoingTransactionsCollection)(nil), // 43: ipc.OutgoingTransactionsCollection
+	(*AbandonTransactionRequest)(nil),      // 44: ipc.AbandonTransactionRequest
+	(*timestamppb.Timestamp)(nil),          // 45: google.protobuf.Timestamp
 }
 var file_ipc_proto_depIdxs = []int32{
-	2,  // 0: ipc.Chain.chain:type_name -> ipc.ChainEnum
+	5,  // 0: ipc.Chain.chain:type_name -> ipc.ChainEnum
 	0,  // 1: ipc.StatusResponse.status:type_name -> ipc.Status
-	9,  // 2: ipc.UTXOCollection.utxos:type_name -> ipc.OwnedUTXO
-	24, // 3: ipc.OwnedUTXO.timestamp_confirmed:type_name -> google.protobuf.Timestamp
-	1,  // 4: ipc.OwnedUTXO.utxo_state:type_name -> ipc.UTXOState
-	10, // 5: ipc.OwnedUTXO.label:type_name -> ipc.Label
-	10, // 6: ipc.LabelsCollection.labels:type_name -> ipc.Label
-	13, // 7: ipc.CreateTransactionRequest.recipients:type_name -> ipc.TransactionRecipient
-	17, // 8: ipc.AddressesCollection.addresses:type_name -> ipc.Address
-	4,  // 9: ipc.IpcService.Status:input_type -> ipc.Empty
-	4,  // 10: ipc.IpcService.SyncHeight:input_type -> ipc.Empty
-	7,  // 11: ipc.IpcService.Unlock:input_type -> ipc.PasswordRequest
-	7,  // 12: ipc.IpcService.SetPassword:input_type -> ipc.PasswordRequest
-	4,  // 13: ipc.IpcService.Shutdown:input_type -> ipc.Empty
-	4,  // 14: ipc.IpcService.ListUTXOs:input_type -> ipc.Empty
-	4,  // 15: ipc.IpcService.ListAddresses:input_type -> ipc.Empty
-	4,  // 16: ipc.IpcService.ListLabels:input_type -> ipc.Empty
-	18, // 17: ipc.IpcService.CreateNewLabel:input_type -> ipc.NewLabelRequest
-	12, // 18: ipc.IpcService.CreateTransaction:input_type -> ipc.CreateTransactionRequest
-	12, // 19: ipc.IpcService.CreateTransactionAndBroadcast:input_type -> ipc.CreateTransactionRequest
-	14, // 20: ipc.IpcService.BroadcastRawTx:input_type -> ipc.RawTransaction
-	4,  // 21: ipc.IpcService.GetMnemonic:input_type -> ipc.Empty
-	20, // 22: ipc.IpcService.SetMnemonic:input_type -> ipc.Mnemonic
-	21, // 23: ipc.IpcService.CreateNewWallet:input_type -> ipc.NewWalletRequest
-	22, // 24: ipc.IpcService.RecoverWallet:input_type -> ipc.RecoverWalletRequest
-	23, // 25: ipc.IpcService.ForceRescanFromHeight:input_type -> ipc.RescanRequest
-	4,  // 26: ipc.IpcService.GetChain:input_type -> ipc.Empty
-	5,  // 27: ipc.IpcService.Status:output_type -> ipc.StatusResponse
-	19, // 28: ipc.IpcService.SyncHeight:output_type -> ipc.SyncHeightResponse
-	8,  // 29: ipc.IpcService.Unlock:output_type -> ipc.BoolResponse
-	8,  // 30: ipc.IpcService.SetPassword:output_type -> ipc.BoolResponse
-	8,  // 31: ipc.IpcService.Shutdown:output_type -> ipc.BoolResponse
-	6,  // 32: ipc.IpcService.ListUTXOs:output_type -> ipc.UTXOCollection
-	16, // 33: ipc.IpcService.ListAddresses:output_type -> ipc.AddressesCollection
-	11, // 34: ipc.IpcService.ListLabels:output_type -> ipc.LabelsCollection
-	17, // 35: ipc.IpcService.CreateNewLabel:output_type -> ipc.Address
-	14, // 36: ipc.IpcService.CreateTransaction:output_type -> ipc.RawTransaction
-	15, // 37: ipc.IpcService.CreateTransactionAndBroadcast:output_type -> ipc.NewTransaction
-	15, // 38: ipc.IpcService.BroadcastRawTx:output_type -> ipc.NewTransaction
-	20, // 39: ipc.IpcService.GetMnemonic:output_type -> ipc.Mnemonic
-	8,  // 40: ipc.IpcService.SetMnemonic:output_type -> ipc.BoolResponse
-	20, // 41: ipc.IpcService.CreateNewWallet:output_type -> ipc.Mnemonic
-	8,  // 42: ipc.IpcService.RecoverWallet:output_type -> ipc.BoolResponse
-	8,  // 43: ipc.IpcService.ForceRescanFromHeight:output_type -> ipc.BoolResponse
-	3,  // 44: ipc.IpcService.GetChain:output_type -> ipc.Chain
-	27, // [27:45] is the sub-list for method output_type
-	9,  // [9:27] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	9,  // 2: ipc.StatusResponse.electrum:type_name -> ipc.ElectrumStatus
+	1,  // 3: ipc.ElectrumStatus.state:type_name -> ipc.ElectrumConnectionState
+	45, // 4: ipc.ElectrumStatus.connected_since:type_name -> google.protobuf.Timestamp
+	13, // 5: ipc.UTXOCollection.utxos:type_name -> ipc.OwnedUTXO
+	45, // 6: ipc.OwnedUTXO.timestamp_confirmed:type_name -> google.protobuf.Timestamp
+	2,  // 7: ipc.OwnedUTXO.utxo_state:type_name -> ipc.UTXOState
+	14, // 8: ipc.OwnedUTXO.label:type_name -> ipc.Label
+	3,  // 9: ipc.OwnedUTXO.source:type_name -> ipc.UTXOSource
+	14, // 10: ipc.LabelsCollection.labels:type_name -> ipc.Label
+	17, // 11: ipc.CreateTransactionRequest.recipients:type_name -> ipc.TransactionRecipient
+	21, // 12: ipc.AddressesCollection.addresses:type_name -> ipc.Address
+	45, // 13: ipc.FeeEstimate.timestamp:type_name -> google.protobuf.Timestamp
+	13, // 14: ipc.TransactionPreview.inputs:type_name -> ipc.OwnedUTXO
+	33, // 15: ipc.TransactionPreview.outputs:type_name -> ipc.PreviewOutput
+	13, // 16: ipc.TransactionPreview.markedSpent:type_name -> ipc.OwnedUTXO
+	17, // 17: ipc.Schedule.recipients:type_name -> ipc.TransactionRecipient
+	45, // 18: ipc.Schedule.startTime:type_name -> google.protobuf.Timestamp
+	45, // 19: ipc.Schedule.nextTime:type_name -> google.protobuf.Timestamp
+	37, // 20: ipc.Schedule.executions:type_name -> ipc.ScheduleExecution
+	45, // 21: ipc.ScheduleExecution.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 22: ipc.SchedulesCollection.schedules:type_name -> ipc.Schedule
+	4,  // 23: ipc.OutgoingTransaction.state:type_name -> ipc.OutgoingTxState
+	45, // 24: ipc.OutgoingTransaction.created:type_name -> google.protobuf.Timestamp
+	45, // 25: ipc.OutgoingTransaction.firstBroadcast:type_name -> google.protobuf.Timestamp
+	45, // 26: ipc.OutgoingTransaction.lastBroadcast:type_name -> google.protobuf.Timestamp
+	42, // 27: ipc.OutgoingTransactionsCollection.transactions:type_name -> ipc.OutgoingTransaction
+	7,  // 28: ipc.IpcService.Status:input_type -> ipc.Empty
+	7,  // 29: ipc.IpcService.SyncHeight:input_type -> ipc.Empty
+	11, // 30: ipc.IpcService.Unlock:input_type -> ipc.PasswordRequest
+	11, // 31: ipc.IpcService.SetPassword:input_type -> ipc.PasswordRequest
+	7,  // 32: ipc.IpcService.Shutdown:input_type -> ipc.Empty
+	7,  // 33: ipc.IpcService.ListUTXOs:input_type -> ipc.Empty
+	7,  // 34: ipc.IpcService.ListAddresses:input_type -> ipc.Empty
+	7,  // 35: ipc.IpcService.ListLabels:input_type -> ipc.Empty
+	22, // 36: ipc.IpcService.CreateNewLabel:input_type -> ipc.NewLabelRequest
+	16, // 37: ipc.IpcService.CreateTransaction:input_type -> ipc.CreateTransactionRequest
+	16, // 38: ipc.IpcService.CreateTransactionAndBroadcast:input_type -> ipc.CreateTransactionRequest
+	18, // 39: ipc.IpcService.BroadcastRawTx:input_type -> ipc.RawTransaction
+	7,  // 40: ipc.IpcService.GetMnemonic:input_type -> ipc.Empty
+	24, // 41: ipc.IpcService.SetMnemonic:input_type -> ipc.Mnemonic
+	25, // 42: ipc.IpcService.CreateNewWallet:input_type -> ipc.NewWalletRequest
+	26, // 43: ipc.IpcService.RecoverWallet:input_type -> ipc.RecoverWalletRequest
+	27, // 44: ipc.IpcService.ForceRescanFromHeight:input_type -> ipc.RescanRequest
+	7,  // 45: ipc.IpcService.GetChain:input_type -> ipc.Empty
+	28, // 46: ipc.IpcService.EstimateFee:input_type -> ipc.EstimateFeeRequest
+	30, // 47: ipc.IpcService.BumpFee:input_type -> ipc.BumpFeeRequest
+	35, // 48: ipc.IpcService.CpfpBump:input_type -> ipc.CpfpBumpRequest
+	16, // 49: ipc.IpcService.PreviewTransaction:input_type -> ipc.CreateTransactionRequest
+	34, // 50: ipc.IpcService.ImportUTXO:input_type -> ipc.ImportUTXORequest
+	22, // 51: ipc.IpcService.NewTaprootAddress:input_type -> ipc.NewLabelRequest
+	7,  // 52: ipc.IpcService.ListTaprootAddresses:input_type -> ipc.Empty
+	36, // 53: ipc.IpcService.CreateSchedule:input_type -> ipc.Schedule
+	7,  // 54: ipc.IpcService.ListSchedules:input_type -> ipc.Empty
+	39, // 55: ipc.IpcService.DeleteSchedule:input_type -> ipc.DeleteScheduleRequest
+	40, // 56: ipc.IpcService.ValidateAddress:input_type -> ipc.ValidateAddressRequest
+	7,  // 57: ipc.IpcService.ListPendingTransactions:input_type -> ipc.Empty
+	44, // 58: ipc.IpcService.AbandonTransaction:input_type -> ipc.AbandonTransactionRequest
+	8,  // 59: ipc.IpcService.Status:output_type -> ipc.StatusResponse
+	23, // 60: ipc.IpcService.SyncHeight:output_type -> ipc.SyncHeightResponse
+	12, // 61: ipc.IpcService.Unlock:output_type -> ipc.BoolResponse
+	12, // 62: ipc.IpcService.SetPassword:output_type -> ipc.BoolResponse
+	12, // 63: ipc.IpcService.Shutdown:output_type -> ipc.BoolResponse
+	10, // 64: ipc.IpcService.ListUTXOs:output_type -> ipc.UTXOCollection
+	20, // 65: ipc.IpcService.ListAddresses:output_type -> ipc.AddressesCollection
+	15, // 66: ipc.IpcService.ListLabels:output_type -> ipc.LabelsCollection
+	21, // 67: ipc.IpcService.CreateNewLabel:output_type -> ipc.Address
+	18, // 68: ipc.IpcService.CreateTransaction:output_type -> ipc.RawTransaction
+	19, // 69: ipc.IpcService.CreateTransactionAndBroadcast:output_type -> ipc.NewTransaction
+	19, // 70: ipc.IpcService.BroadcastRawTx:output_type -> ipc.NewTransaction
+	24, // 71: ipc.IpcService.GetMnemonic:output_type -> ipc.Mnemonic
+	12, // 72: ipc.IpcService.SetMnemonic:output_type -> ipc.BoolResponse
+	24, // 73: ipc.IpcService.CreateNewWallet:output_type -> ipc.Mnemonic
+	12, // 74: ipc.IpcService.RecoverWallet:output_type -> ipc.BoolResponse
+	12, // 75: ipc.IpcService.ForceRescanFromHeight:output_type -> ipc.BoolResponse
+	6,  // 76: ipc.IpcService.GetChain:output_type -> ipc.Chain
+	29, // 77: ipc.IpcService.EstimateFee:output_type -> ipc.FeeEstimate
+	31, // 78: ipc.IpcService.BumpFee:output_type -> ipc.BumpFeeResponse
+	31, // 79: ipc.IpcService.CpfpBump:output_type -> ipc.BumpFeeResponse
+	32, // 80: ipc.IpcService.PreviewTransaction:output_type -> ipc.TransactionPreview
+	13, // 81: ipc.IpcService.ImportUTXO:output_type -> ipc.OwnedUTXO
+	21, // 82: ipc.IpcService.NewTaprootAddress:output_type -> ipc.Address
+	20, // 83: ipc.IpcService.ListTaprootAddresses:output_type -> ipc.AddressesCollection
+	36, // 84: ipc.IpcService.CreateSchedule:output_type -> ipc.Schedule
+	38, // 85: ipc.IpcService.ListSchedules:output_type -> ipc.SchedulesCollection
+	12, // 86: ipc.IpcService.DeleteSchedule:output_type -> ipc.BoolResponse
+	41, // 87: ipc.IpcService.ValidateAddress:output_type -> ipc.AddressInfo
+	43, // 88: ipc.IpcService.ListPendingTransactions:output_type -> ipc.OutgoingTransactionsCollection
+	12, // 89: ipc.IpcService.AbandonTransaction:output_type -> ipc.BoolResponse
+	59, // [59:90] is the sub-list for method output_type
+	28, // [28:59] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_ipc_proto_init() }
@@ -1669,7 +3559,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UTXOCollection); i {
+			switch v := v.(*ElectrumStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1681,7 +3571,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordRequest); i {
+			switch v := v.(*UTXOCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1693,7 +3583,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolResponse); i {
+			switch v := v.(*PasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1705,7 +3595,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OwnedUTXO); i {
+			switch v := v.(*BoolResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1717,7 +3607,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Label); i {
+			switch v := v.(*OwnedUTXO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1729,7 +3619,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LabelsCollection); i {
+			switch v := v.(*Label); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1741,7 +3631,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTransactionRequest); i {
+			switch v := v.(*LabelsCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1753,7 +3643,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionRecipient); i {
+			switch v := v.(*CreateTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1765,7 +3655,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawTransaction); i {
+			switch v := v.(*TransactionRecipient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1777,7 +3667,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewTransaction); i {
+			switch v := v.(*RawTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1789,7 +3679,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressesCollection); i {
+			switch v := v.(*NewTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1801,7 +3691,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+			switch v := v.(*AddressesCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1813,7 +3703,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewLabelRequest); i {
+			switch v := v.(*Address); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1825,7 +3715,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncHeightResponse); i {
+			switch v := v.(*NewLabelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1837,7 +3727,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mnemonic); i {
+			switch v := v.(*SyncHeightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1849,7 +3739,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewWalletRequest); i {
+			switch v := v.(*Mnemonic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1861,7 +3751,7 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverWalletRequest); i {
+			switch v := v.(*NewWalletRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1873,6 +3763,18 @@ func file_ipc_proto_init() {
 			}
 		}
 		file_ipc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RescanRequest); i {
 			case 0:
 				return &v.state
@@ -1884,16 +3786,221 @@ func file_ipc_proto_init() {
 				return nil
 			}
 		}
+		file_ipc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUTXORequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpfpBumpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleExecution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulesCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutgoingTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutgoingTransactionsCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ipc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbandonTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_ipc_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_ipc_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_ipc_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_ipc_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_ipc_proto_msgTypes[35].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ipc_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   21,
+			NumEnums:      6,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
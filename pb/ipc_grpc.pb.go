@@ -37,6 +37,19 @@ const (
 	IpcService_RecoverWallet_FullMethodName                 = "/ipc.IpcService/RecoverWallet"
 	IpcService_ForceRescanFromHeight_FullMethodName         = "/ipc.IpcService/ForceRescanFromHeight"
 	IpcService_GetChain_FullMethodName                      = "/ipc.IpcService/GetChain"
+	IpcService_EstimateFee_FullMethodName                   = "/ipc.IpcService/EstimateFee"
+	IpcService_BumpFee_FullMethodName                       = "/ipc.IpcService/BumpFee"
+	IpcService_CpfpBump_FullMethodName                      = "/ipc.IpcService/CpfpBump"
+	IpcService_PreviewTransaction_FullMethodName            = "/ipc.IpcService/PreviewTransaction"
+	IpcService_ImportUTXO_FullMethodName                    = "/ipc.IpcService/ImportUTXO"
+	IpcService_NewTaprootAddress_FullMethodName             = "/ipc.IpcService/NewTaprootAddress"
+	IpcService_ListTaprootAddresses_FullMethodName          = "/ipc.IpcService/ListTaprootAddresses"
+	IpcService_CreateSchedule_FullMethodName                = "/ipc.IpcService/CreateSchedule"
+	IpcService_ListSchedules_FullMethodName                 = "/ipc.IpcService/ListSchedules"
+	IpcService_DeleteSchedule_FullMethodName                = "/ipc.IpcService/DeleteSchedule"
+	IpcService_ValidateAddress_FullMethodName               = "/ipc.IpcService/ValidateAddress"
+	IpcService_ListPendingTransactions_FullMethodName       = "/ipc.IpcService/ListPendingTransactions"
+	IpcService_AbandonTransaction_FullMethodName            = "/ipc.IpcService/AbandonTransaction"
 )
 
 // IpcServiceClient is the client API for IpcService service.
@@ -62,6 +75,19 @@ type IpcServiceClient interface {
 	RecoverWallet(ctx context.Context, in *RecoverWalletRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	ForceRescanFromHeight(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	GetChain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Chain, error)
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*FeeEstimate, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	CpfpBump(ctx context.Context, in *CpfpBumpRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	PreviewTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*TransactionPreview, error)
+	ImportUTXO(ctx context.Context, in *ImportUTXORequest, opts ...grpc.CallOption) (*OwnedUTXO, error)
+	NewTaprootAddress(ctx context.Context, in *NewLabelRequest, opts ...grpc.CallOption) (*Address, error)
+	ListTaprootAddresses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AddressesCollection, error)
+	CreateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	ListSchedules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulesCollection, error)
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*AddressInfo, error)
+	ListPendingTransactions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OutgoingTransactionsCollection, error)
+	AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 }
 
 type ipcServiceClient struct {
@@ -234,6 +260,123 @@ func (c *ipcServiceClient) GetChain(ctx context.Context, in *Empty, opts ...grpc
 	return out, nil
 }
 
+func (c *ipcServiceClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*FeeEstimate, error) {
+	out := new(FeeEstimate)
+	err := c.cc.Invoke(ctx, IpcService_EstimateFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, IpcService_BumpFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) CpfpBump(ctx context.Context, in *CpfpBumpRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, IpcService_CpfpBump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) PreviewTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*TransactionPreview, error) {
+	out := new(TransactionPreview)
+	err := c.cc.Invoke(ctx, IpcService_PreviewTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) ImportUTXO(ctx context.Context, in *ImportUTXORequest, opts ...grpc.CallOption) (*OwnedUTXO, error) {
+	out := new(OwnedUTXO)
+	err := c.cc.Invoke(ctx, IpcService_ImportUTXO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) NewTaprootAddress(ctx context.Context, in *NewLabelRequest, opts ...grpc.CallOption) (*Address, error) {
+	out := new(Address)
+	err := c.cc.Invoke(ctx, IpcService_NewTaprootAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) ListTaprootAddresses(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AddressesCollection, error) {
+	out := new(AddressesCollection)
+	err := c.cc.Invoke(ctx, IpcService_ListTaprootAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) CreateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, IpcService_CreateSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) ListSchedules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SchedulesCollection, error) {
+	out := new(SchedulesCollection)
+	err := c.cc.Invoke(ctx, IpcService_ListSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, IpcService_DeleteSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, IpcService_ValidateAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) ListPendingTransactions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OutgoingTransactionsCollection, error) {
+	out := new(OutgoingTransactionsCollection)
+	err := c.cc.Invoke(ctx, IpcService_ListPendingTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ipcServiceClient) AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, IpcService_AbandonTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IpcServiceServer is the server API for IpcService service.
 // All implementations must embed UnimplementedIpcServiceServer
 // for forward compatibility
@@ -257,6 +400,19 @@ type IpcServiceServer interface {
 	RecoverWallet(context.Context, *RecoverWalletRequest) (*BoolResponse, error)
 	ForceRescanFromHeight(context.Context, *RescanRequest) (*BoolResponse, error)
 	GetChain(context.Context, *Empty) (*Chain, error)
+	EstimateFee(context.Context, *EstimateFeeRequest) (*FeeEstimate, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	CpfpBump(context.Context, *CpfpBumpRequest) (*BumpFeeResponse, error)
+	PreviewTransaction(context.Context, *CreateTransactionRequest) (*TransactionPreview, error)
+	ImportUTXO(context.Context, *ImportUTXORequest) (*OwnedUTXO, error)
+	NewTaprootAddress(context.Context, *NewLabelRequest) (*Address, error)
+	ListTaprootAddresses(context.Context, *Empty) (*AddressesCollection, error)
+	CreateSchedule(context.Context, *Schedule) (*Schedule, error)
+	ListSchedules(context.Context, *Empty) (*SchedulesCollection, error)
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*BoolResponse, error)
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*AddressInfo, error)
+	ListPendingTransactions(context.Context, *Empty) (*OutgoingTransactionsCollection, error)
+	AbandonTransaction(context.Context, *AbandonTransactionRequest) (*BoolResponse, error)
 	mustEmbedUnimplementedIpcServiceServer()
 }
 
@@ -318,6 +474,45 @@ func (UnimplementedIpcServiceServer) ForceRescanFromHeight(context.Context, *Res
 func (UnimplementedIpcServiceServer) GetChain(context.Context, *Empty) (*Chain, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChain not implemented")
 }
+func (UnimplementedIpcServiceServer) EstimateFee(context.Context, *EstimateFeeRequest) (*FeeEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (UnimplementedIpcServiceServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (UnimplementedIpcServiceServer) CpfpBump(context.Context, *CpfpBumpRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CpfpBump not implemented")
+}
+func (UnimplementedIpcServiceServer) PreviewTransaction(context.Context, *CreateTransactionRequest) (*TransactionPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTransaction not implemented")
+}
+func (UnimplementedIpcServiceServer) ImportUTXO(context.Context, *ImportUTXORequest) (*OwnedUTXO, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUTXO not implemented")
+}
+func (UnimplementedIpcServiceServer) NewTaprootAddress(context.Context, *NewLabelRequest) (*Address, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewTaprootAddress not implemented")
+}
+func (UnimplementedIpcServiceServer) ListTaprootAddresses(context.Context, *Empty) (*AddressesCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaprootAddresses not implemented")
+}
+func (UnimplementedIpcServiceServer) CreateSchedule(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedIpcServiceServer) ListSchedules(context.Context, *Empty) (*SchedulesCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedIpcServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedIpcServiceServer) ValidateAddress(context.Context, *ValidateAddressRequest) (*AddressInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddress not implemented")
+}
+func (UnimplementedIpcServiceServer) ListPendingTransactions(context.Context, *Empty) (*OutgoingTransactionsCollection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingTransactions not implemented")
+}
+func (UnimplementedIpcServiceServer) AbandonTransaction(context.Context, *AbandonTransactionRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonTransaction not implemented")
+}
 func (UnimplementedIpcServiceServer) mustEmbedUnimplementedIpcServiceServer() {}
 
 // UnsafeIpcServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -655,6 +850,240 @@ func _IpcService_GetChain_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IpcService_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_EstimateFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_BumpFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_CpfpBump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CpfpBumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).CpfpBump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_CpfpBump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).CpfpBump(ctx, req.(*CpfpBumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_PreviewTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).PreviewTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_PreviewTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).PreviewTransaction(ctx, req.(*CreateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_ImportUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUTXORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).ImportUTXO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_ImportUTXO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).ImportUTXO(ctx, req.(*ImportUTXORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_NewTaprootAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).NewTaprootAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_NewTaprootAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).NewTaprootAddress(ctx, req.(*NewLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_ListTaprootAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).ListTaprootAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_ListTaprootAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).ListTaprootAddresses(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).CreateSchedule(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).ListSchedules(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).ValidateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_ValidateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_ListPendingTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).ListPendingTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_ListPendingTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).ListPendingTransactions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IpcService_AbandonTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IpcServiceServer).AbandonTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IpcService_AbandonTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IpcServiceServer).AbandonTransaction(ctx, req.(*AbandonTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IpcService_ServiceDesc is the grpc.ServiceDesc for IpcService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -734,6 +1163,58 @@ var IpcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChain",
 			Handler:    _IpcService_GetChain_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _IpcService_EstimateFee_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _IpcService_BumpFee_Handler,
+		},
+		{
+			MethodName: "CpfpBump",
+			Handler:    _IpcService_CpfpBump_Handler,
+		},
+		{
+			MethodName: "PreviewTransaction",
+			Handler:    _IpcService_PreviewTransaction_Handler,
+		},
+		{
+			MethodName: "ImportUTXO",
+			Handler:    _IpcService_ImportUTXO_Handler,
+		},
+		{
+			MethodName: "NewTaprootAddress",
+			Handler:    _IpcService_NewTaprootAddress_Handler,
+		},
+		{
+			MethodName: "ListTaprootAddresses",
+			Handler:    _IpcService_ListTaprootAddresses_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _IpcService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _IpcService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _IpcService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ValidateAddress",
+			Handler:    _IpcService_ValidateAddress_Handler,
+		},
+		{
+			MethodName: "ListPendingTransactions",
+			Handler:    _IpcService_ListPendingTransactions_Handler,
+		},
+		{
+			MethodName: "AbandonTransaction",
+			Handler:    _IpcService_AbandonTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ipc.proto",
@@ -0,0 +1,886 @@
+// The BlindBit Oracle gRPC interface as used by networking.ClientBlindBitGRPC, pb/oracle.pb.go and
+// pb/oracle_grpc.pb.go are generated from this file:
+//
+//   protoc -I pb --go_out=. --go-grpc_out=. pb/oracle.proto
+//
+// NOTE: this is not the upstream definition. It has to be replaced by oracle.proto of the BlindBit-Protos submodule
+// (https://github.com/setavenger/BlindBit-Protos) and pb regenerated from it. Until then the package, service and
+// field numbers are unverified and the grpc indexer protocol may not work against a BlindBit Oracle server.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v5.26.1
+// source: oracle.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FilterType int32
+
+const (
+	FilterType_FILTER_TYPE_UNSPECIFIED FilterType = 0
+	FilterType_FILTER_TYPE_SPENT       FilterType = 1
+	FilterType_FILTER_TYPE_NEW_UTXOS   FilterType = 2
+)
+
+// Enum value maps for FilterType.
+var (
+	FilterType_name = map[int32]string{
+		0: "FILTER_TYPE_UNSPECIFIED",
+		1: "FILTER_TYPE_SPENT",
+		2: "FILTER_TYPE_NEW_UTXOS",
+	}
+	FilterType_value = map[string]int32{
+		"FILTER_TYPE_UNSPECIFIED": 0,
+		"FILTER_TYPE_SPENT":       1,
+		"FILTER_TYPE_NEW_UTXOS":   2,
+	}
+)
+
+func (x FilterType) Enum() *FilterType {
+	p := new(FilterType)
+	*p = x
+	return p
+}
+
+func (x FilterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_oracle_proto_enumTypes[0].Descriptor()
+}
+
+func (FilterType) Type() protoreflect.EnumType {
+	return &file_oracle_proto_enumTypes[0]
+}
+
+func (x FilterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterType.Descriptor instead.
+func (FilterType) EnumDescriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+type OracleEmpty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OracleEmpty) Reset() {
+	*x = OracleEmpty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleEmpty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleEmpty) ProtoMessage() {}
+
+func (x *OracleEmpty) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleEmpty.ProtoReflect.Descriptor instead.
+func (*OracleEmpty) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+type BlockHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	DustLimit   uint64 `protobuf:"varint,2,opt,name=dustLimit,proto3" json:"dustLimit,omitempty"` // only return tweaks of transactions where the largest output exceeds the dust limit
+}
+
+func (x *BlockHeightRequest) Reset() {
+	*x = BlockHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeightRequest) ProtoMessage() {}
+
+func (x *BlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*BlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockHeightRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *BlockHeightRequest) GetDustLimit() uint64 {
+	if x != nil {
+		return x.DustLimit
+	}
+	return 0
+}
+
+type BlockHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+}
+
+func (x *BlockHeightResponse) Reset() {
+	*x = BlockHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeightResponse) ProtoMessage() {}
+
+func (x *BlockHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeightResponse.ProtoReflect.Descriptor instead.
+func (*BlockHeightResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockHeightResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type TweakArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tweaks [][]byte `protobuf:"bytes,1,rep,name=tweaks,proto3" json:"tweaks,omitempty"` // 33 byte compressed public keys
+}
+
+func (x *TweakArray) Reset() {
+	*x = TweakArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TweakArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TweakArray) ProtoMessage() {}
+
+func (x *TweakArray) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TweakArray.ProtoReflect.Descriptor instead.
+func (*TweakArray) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TweakArray) GetTweaks() [][]byte {
+	if x != nil {
+		return x.Tweaks
+	}
+	return nil
+}
+
+type GetFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64     `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	FilterType  FilterType `protobuf:"varint,2,opt,name=filterType,proto3,enum=oracle.FilterType" json:"filterType,omitempty"`
+}
+
+func (x *GetFilterRequest) Reset() {
+	*x = GetFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterRequest) ProtoMessage() {}
+
+func (x *GetFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFilterRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *GetFilterRequest) GetFilterType() FilterType {
+	if x != nil {
+		return x.FilterType
+	}
+	return FilterType_FILTER_TYPE_UNSPECIFIED
+}
+
+type FilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilterType  FilterType `protobuf:"varint,1,opt,name=filterType,proto3,enum=oracle.FilterType" json:"filterType,omitempty"`
+	BlockHeight uint64     `protobuf:"varint,2,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	BlockHash   []byte     `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Data        []byte     `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"` // BIP 158 encoded filter
+}
+
+func (x *FilterResponse) Reset() {
+	*x = FilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterResponse) ProtoMessage() {}
+
+func (x *FilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterResponse.ProtoReflect.Descriptor instead.
+func (*FilterResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FilterResponse) GetFilterType() FilterType {
+	if x != nil {
+		return x.FilterType
+	}
+	return FilterType_FILTER_TYPE_UNSPECIFIED
+}
+
+func (x *FilterResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *FilterResponse) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *FilterResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ServedUTXO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid         []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout         uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Value        uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	ScriptPubKey []byte `protobuf:"bytes,4,opt,name=scriptPubKey,proto3" json:"scriptPubKey,omitempty"`
+	BlockHeight  uint64 `protobuf:"varint,5,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	BlockHash    []byte `protobuf:"bytes,6,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Timestamp    uint64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Spent        bool   `protobuf:"varint,8,opt,name=spent,proto3" json:"spent,omitempty"`
+}
+
+func (x *ServedUTXO) Reset() {
+	*x = ServedUTXO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServedUTXO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServedUTXO) ProtoMessage() {}
+
+func (x *ServedUTXO) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServedUTXO.ProtoReflect.Descriptor instead.
+func (*ServedUTXO) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServedUTXO) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *ServedUTXO) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *ServedUTXO) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ServedUTXO) GetScriptPubKey() []byte {
+	if x != nil {
+		return x.ScriptPubKey
+	}
+	return nil
+}
+
+func (x *ServedUTXO) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ServedUTXO) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ServedUTXO) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ServedUTXO) GetSpent() bool {
+	if x != nil {
+		return x.Spent
+	}
+	return false
+}
+
+type UTXOArrayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utxos []*ServedUTXO `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+}
+
+func (x *UTXOArrayResponse) Reset() {
+	*x = UTXOArrayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTXOArrayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTXOArrayResponse) ProtoMessage() {}
+
+func (x *UTXOArrayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTXOArrayResponse.ProtoReflect.Descriptor instead.
+func (*UTXOArrayResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UTXOArrayResponse) GetUtxos() []*ServedUTXO {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type SpentOutpointsIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash   []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockHeight uint64   `protobuf:"varint,2,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Data        [][]byte `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"` // 8 byte prefixes of the hashed spent outpoints
+}
+
+func (x *SpentOutpointsIndexResponse) Reset() {
+	*x = SpentOutpointsIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpentOutpointsIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpentOutpointsIndexResponse) ProtoMessage() {}
+
+func (x *SpentOutpointsIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpentOutpointsIndexResponse.ProtoReflect.Descriptor instead.
+func (*SpentOutpointsIndexResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SpentOutpointsIndexResponse) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *SpentOutpointsIndexResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *SpentOutpointsIndexResponse) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_oracle_proto protoreflect.FileDescriptor
+
+var file_oracle_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x54, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x64, 0x75, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x37, 0x0a, 0x13, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x24, 0x0a, 0x0a, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x06, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x73, 0x22, 0x68, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xe2, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x54, 0x58, 0x4f, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x75, 0x74, 0x78,
+	0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x05, 0x75, 0x74,
+	0x78, 0x6f, 0x73, 0x22, 0x71, 0x0a, 0x1b, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x5b, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x50, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x49, 0x4c, 0x54,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x55, 0x54, 0x58, 0x4f,
+	0x53, 0x10, 0x02, 0x32, 0xbf, 0x03, 0x0a, 0x0d, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x13, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1b, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x1a,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x44,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x1a, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x41, 0x72,
+	0x72, 0x61, 0x79, 0x12, 0x1a, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x55, 0x54, 0x58, 0x4f, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x4f,
+	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oracle_proto_rawDescOnce sync.Once
+	file_oracle_proto_rawDescData = file_oracle_proto_rawDesc
+)
+
+func file_oracle_proto_rawDescGZIP() []byte {
+	file_oracle_proto_rawDescOnce.Do(func() {
+		file_oracle_proto_rawDescData = protoimpl.X.CompressGZIP(file_oracle_proto_rawDescData)
+	})
+	return file_oracle_proto_rawDescData
+}
+
+var file_oracle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_oracle_proto_goTypes = []interface{}{
+	(FilterType)(0),                     // 0: oracle.FilterType
+	(*OracleEmpty)(nil),                 // 1: oracle.OracleEmpty
+	(*BlockHeightRequest)(nil),          // 2: oracle.BlockHeightRequest
+	(*BlockHeightResponse)(nil),         // 3: oracle.BlockHeightResponse
+	(*TweakArray)(nil),                  // 4: oracle.TweakArray
+	(*GetFilterRequest)(nil),            // 5: oracle.GetFilterRequest
+	(*FilterResponse)(nil),              // 6: oracle.FilterResponse
+	(*ServedUTXO)(nil),                  // 7: oracle.ServedUTXO
+	(*UTXOArrayResponse)(nil),           // 8: oracle.UTXOArrayResponse
+	(*SpentOutpointsIndexResponse)(nil), // 9: oracle.SpentOutpointsIndexResponse
+}
+var file_oracle_proto_depIdxs = []int32{
+	0, // 0: oracle.GetFilterRequest.filterType:type_name -> oracle.FilterType
+	0, // 1: oracle.FilterResponse.filterType:type_name -> oracle.FilterType
+	7, // 2: oracle.UTXOArrayResponse.utxos:type_name -> oracle.ServedUTXO
+	1, // 3: oracle.OracleService.GetBestBlockHeight:input_type -> oracle.OracleEmpty
+	2, // 4: oracle.OracleService.GetTweakArray:input_type -> oracle.BlockHeightRequest
+	2, // 5: oracle.OracleService.GetTweakIndexArray:input_type -> oracle.BlockHeightRequest
+	5, // 6: oracle.OracleService.GetFilter:input_type -> oracle.GetFilterRequest
+	2, // 7: oracle.OracleService.GetUTXOArray:input_type -> oracle.BlockHeightRequest
+	2, // 8: oracle.OracleService.GetSpentOutpointsIndex:input_type -> oracle.BlockHeightRequest
+	3, // 9: oracle.OracleService.GetBestBlockHeight:output_type -> oracle.BlockHeightResponse
+	4, // 10: oracle.OracleService.GetTweakArray:output_type -> oracle.TweakArray
+	4, // 11: oracle.OracleService.GetTweakIndexArray:output_type -> oracle.TweakArray
+	6, // 12: oracle.OracleService.GetFilter:output_type -> oracle.FilterResponse
+	8, // 13: oracle.OracleService.GetUTXOArray:output_type -> oracle.UTXOArrayResponse
+	9, // 14: oracle.OracleService.GetSpentOutpointsIndex:output_type -> oracle.SpentOutpointsIndexResponse
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_oracle_proto_init() }
+func file_oracle_proto_init() {
+	if File_oracle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oracle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OracleEmpty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TweakArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServedUTXO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTXOArrayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpentOutpointsIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_oracle_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_oracle_proto_goTypes,
+		DependencyIndexes: file_oracle_proto_depIdxs,
+		EnumInfos:         file_oracle_proto_enumTypes,
+		MessageInfos:      file_oracle_proto_msgTypes,
+	}.Build()
+	File_oracle_proto = out.File
+	file_oracle_proto_rawDesc = nil
+	file_oracle_proto_goTypes = nil
+	file_oracle_proto_depIdxs = nil
+}
@@ -0,0 +1,303 @@
+// The BlindBit Oracle gRPC interface as used by networking.ClientBlindBitGRPC, pb/oracle.pb.go and
+// pb/oracle_grpc.pb.go are generated from this file:
+//
+//   protoc -I pb --go_out=. --go-grpc_out=. pb/oracle.proto
+//
+// NOTE: this is not the upstream definition. It has to be replaced by oracle.proto of the BlindBit-Protos submodule
+// (https://github.com/setavenger/BlindBit-Protos) and pb regenerated from it. Until then the package, service and
+// field numbers are unverified and the grpc indexer protocol may not work against a BlindBit Oracle server.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: oracle.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	OracleService_GetBestBlockHeight_FullMethodName     = "/oracle.OracleService/GetBestBlockHeight"
+	OracleService_GetTweakArray_FullMethodName          = "/oracle.OracleService/GetTweakArray"
+	OracleService_GetTweakIndexArray_FullMethodName     = "/oracle.OracleService/GetTweakIndexArray"
+	OracleService_GetFilter_FullMethodName              = "/oracle.OracleService/GetFilter"
+	OracleService_GetUTXOArray_FullMethodName           = "/oracle.OracleService/GetUTXOArray"
+	OracleService_GetSpentOutpointsIndex_FullMethodName = "/oracle.OracleService/GetSpentOutpointsIndex"
+)
+
+// OracleServiceClient is the client API for OracleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OracleServiceClient interface {
+	GetBestBlockHeight(ctx context.Context, in *OracleEmpty, opts ...grpc.CallOption) (*BlockHeightResponse, error)
+	GetTweakArray(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*TweakArray, error)
+	GetTweakIndexArray(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*TweakArray, error)
+	GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*FilterResponse, error)
+	GetUTXOArray(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*UTXOArrayResponse, error)
+	GetSpentOutpointsIndex(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*SpentOutpointsIndexResponse, error)
+}
+
+type oracleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOracleServiceClient(cc grpc.ClientConnInterface) OracleServiceClient {
+	return &oracleServiceClient{cc}
+}
+
+func (c *oracleServiceClient) GetBestBlockHeight(ctx context.Context, in *OracleEmpty, opts ...grpc.CallOption) (*BlockHeightResponse, error) {
+	out := new(BlockHeightResponse)
+	err := c.cc.Invoke(ctx, OracleService_GetBestBlockHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleServiceClient) GetTweakArray(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*TweakArray, error) {
+	out := new(TweakArray)
+	err := c.cc.Invoke(ctx, OracleService_GetTweakArray_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleServiceClient) GetTweakIndexArray(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*TweakArray, error) {
+	out := new(TweakArray)
+	err := c.cc.Invoke(ctx, OracleService_GetTweakIndexArray_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleServiceClient) GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*FilterResponse, error) {
+	out := new(FilterResponse)
+	err := c.cc.Invoke(ctx, OracleService_GetFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleServiceClient) GetUTXOArray(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*UTXOArrayResponse, error) {
+	out := new(UTXOArrayResponse)
+	err := c.cc.Invoke(ctx, OracleService_GetUTXOArray_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oracleServiceClient) GetSpentOutpointsIndex(ctx context.Context, in *BlockHeightRequest, opts ...grpc.CallOption) (*SpentOutpointsIndexResponse, error) {
+	out := new(SpentOutpointsIndexResponse)
+	err := c.cc.Invoke(ctx, OracleService_GetSpentOutpointsIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OracleServiceServer is the server API for OracleService service.
+// All implementations must embed UnimplementedOracleServiceServer
+// for forward compatibility
+type OracleServiceServer interface {
+	GetBestBlockHeight(context.Context, *OracleEmpty) (*BlockHeightResponse, error)
+	GetTweakArray(context.Context, *BlockHeightRequest) (*TweakArray, error)
+	GetTweakIndexArray(context.Context, *BlockHeightRequest) (*TweakArray, error)
+	GetFilter(context.Context, *GetFilterRequest) (*FilterResponse, error)
+	GetUTXOArray(context.Context, *BlockHeightRequest) (*UTXOArrayResponse, error)
+	GetSpentOutpointsIndex(context.Context, *BlockHeightRequest) (*SpentOutpointsIndexResponse, error)
+	mustEmbedUnimplementedOracleServiceServer()
+}
+
+// UnimplementedOracleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOracleServiceServer struct {
+}
+
+func (UnimplementedOracleServiceServer) GetBestBlockHeight(context.Context, *OracleEmpty) (*BlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBestBlockHeight not implemented")
+}
+func (UnimplementedOracleServiceServer) GetTweakArray(context.Context, *BlockHeightRequest) (*TweakArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweakArray not implemented")
+}
+func (UnimplementedOracleServiceServer) GetTweakIndexArray(context.Context, *BlockHeightRequest) (*TweakArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTweakIndexArray not implemented")
+}
+func (UnimplementedOracleServiceServer) GetFilter(context.Context, *GetFilterRequest) (*FilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilter not implemented")
+}
+func (UnimplementedOracleServiceServer) GetUTXOArray(context.Context, *BlockHeightRequest) (*UTXOArrayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUTXOArray not implemented")
+}
+func (UnimplementedOracleServiceServer) GetSpentOutpointsIndex(context.Context, *BlockHeightRequest) (*SpentOutpointsIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpentOutpointsIndex not implemented")
+}
+func (UnimplementedOracleServiceServer) mustEmbedUnimplementedOracleServiceServer() {}
+
+// UnsafeOracleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OracleServiceServer will
+// result in compilation errors.
+type UnsafeOracleServiceServer interface {
+	mustEmbedUnimplementedOracleServiceServer()
+}
+
+func RegisterOracleServiceServer(s grpc.ServiceRegistrar, srv OracleServiceServer) {
+	s.RegisterService(&OracleService_ServiceDesc, srv)
+}
+
+func _OracleService_GetBestBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OracleEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServiceServer).GetBestBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OracleService_GetBestBlockHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServiceServer).GetBestBlockHeight(ctx, req.(*OracleEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OracleService_GetTweakArray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServiceServer).GetTweakArray(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OracleService_GetTweakArray_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServiceServer).GetTweakArray(ctx, req.(*BlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OracleService_GetTweakIndexArray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServiceServer).GetTweakIndexArray(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OracleService_GetTweakIndexArray_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServiceServer).GetTweakIndexArray(ctx, req.(*BlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OracleService_GetFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServiceServer).GetFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OracleService_GetFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServiceServer).GetFilter(ctx, req.(*GetFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OracleService_GetUTXOArray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServiceServer).GetUTXOArray(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OracleService_GetUTXOArray_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServiceServer).GetUTXOArray(ctx, req.(*BlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OracleService_GetSpentOutpointsIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServiceServer).GetSpentOutpointsIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OracleService_GetSpentOutpointsIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServiceServer).GetSpentOutpointsIndex(ctx, req.(*BlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OracleService_ServiceDesc is the grpc.ServiceDesc for OracleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OracleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "oracle.OracleService",
+	HandlerType: (*OracleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBestBlockHeight",
+			Handler:    _OracleService_GetBestBlockHeight_Handler,
+		},
+		{
+			MethodName: "GetTweakArray",
+			Handler:    _OracleService_GetTweakArray_Handler,
+		},
+		{
+			MethodName: "GetTweakIndexArray",
+			Handler:    _OracleService_GetTweakIndexArray_Handler,
+		},
+		{
+			MethodName: "GetFilter",
+			Handler:    _OracleService_GetFilter_Handler,
+		},
+		{
+			MethodName: "GetUTXOArray",
+			Handler:    _OracleService_GetUTXOArray_Handler,
+		},
+		{
+			MethodName: "GetSpentOutpointsIndex",
+			Handler:    _OracleService_GetSpentOutpointsIndex_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "oracle.proto",
+}
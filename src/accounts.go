@@ -0,0 +1,188 @@
+package src
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// LoadAccountKeys
+// parses the account keys of the regular (non SP) accounts. Keys from older wallets might not have them, that is not an error.
+func (w *Wallet) LoadAccountKeys(keys *Keys) error {
+	w.accountKeys = make(map[ScriptType]*hdkeychain.ExtendedKey)
+
+	for scriptType, serialised := range map[ScriptType]string{
+		ScriptTypeP2WPKH: keys.AccountKeyP2WPKH,
+		ScriptTypeP2TR:   keys.AccountKeyP2TR,
+	} {
+		if serialised == "" {
+			continue
+		}
+		accountKey, err := hdkeychain.NewKeyFromString(serialised)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		w.accountKeys[scriptType] = accountKey
+	}
+
+	return nil
+}
+
+func (w *Wallet) HasAccountKeys() bool {
+	return len(w.accountKeys) > 0
+}
+
+// DeriveAccountPrivKey returns the private key of the account given by scriptType at path
+func (w *Wallet) DeriveAccountPrivKey(scriptType ScriptType, path *DerivationPath) (*btcec.PrivateKey, error) {
+	extendedKey, err := w.deriveAccountKey(scriptType, path)
+	if err != nil {
+		return nil, err
+	}
+	return extendedKey.ECPrivKey()
+}
+
+// DeriveAccountPkScript returns the scriptPubKey of the account given by scriptType at path
+func (w *Wallet) DeriveAccountPkScript(scriptType ScriptType, path *DerivationPath) ([]byte, error) {
+	extendedKey, err := w.deriveAccountKey(scriptType, path)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := extendedKey.ECPubKey()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	switch scriptType {
+	case ScriptTypeP2WPKH:
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(btcutil.Hash160(pubKey.SerializeCompressed())).Script()
+	case ScriptTypeP2TR:
+		// BIP 86: key-path only, no script tree
+		outputKey := txscript.ComputeTaprootKeyNoScript(pubKey)
+		return txscript.PayToTaprootScript(outputKey)
+	default:
+		return nil, fmt.Errorf("script type %d has no account", scriptType)
+	}
+}
+
+// FindDerivationPath
+// searches the receive and change chains of the regular accounts up to gapLimit for pkScript.
+func (w *Wallet) FindDerivationPath(pkScript []byte, gapLimit uint32) (ScriptType, *DerivationPath, error) {
+	if !w.HasAccountKeys() {
+		return ScriptTypeSilentPayment, nil, ErrNoAccountKeys
+	}
+
+	var scriptType ScriptType
+	switch {
+	case len(pkScript) == 22 && pkScript[0] == txscript.OP_0 && pkScript[1] == txscript.OP_DATA_20:
+		scriptType = ScriptTypeP2WPKH
+	case len(pkScript) == 34 && pkScript[0] == txscript.OP_1 && pkScript[1] == txscript.OP_DATA_32:
+		scriptType = ScriptTypeP2TR
+	default:
+		return ScriptTypeSilentPayment, nil, fmt.Errorf("unsupported script %x", pkScript)
+	}
+
+	for index := uint32(0); index < gapLimit; index++ {
+		for change := uint32(0); change < 2; change++ {
+			path := &DerivationPath{Change: change, Index: index}
+			candidate, err := w.DeriveAccountPkScript(scriptType, path)
+			if err != nil {
+				return ScriptTypeSilentPayment, nil, err
+			}
+			if bytes.Equal(candidate, pkScript) {
+				return scriptType, path, nil
+			}
+		}
+	}
+
+	return ScriptTypeSilentPayment, nil, fmt.Errorf("script %x does not belong to this wallet", pkScript)
+}
+
+func (w *Wallet) deriveAccountKey(scriptType ScriptType, path *DerivationPath) (*hdkeychain.ExtendedKey, error) {
+	if path == nil {
+		return nil, errors.New("derivation path is missing")
+	}
+	accountKey, ok := w.accountKeys[scriptType]
+	if !ok {
+		return nil, ErrNoAccountKeys
+	}
+
+	changeKey, err := accountKey.Derive(path.Change)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	return changeKey.Derive(path.Index)
+}
+
+// taprootOutputPrivKey returns the BIP 86 tweaked private key which belongs to the output key
+func taprootOutputPrivKey(privKey *btcec.PrivateKey) *btcec.PrivateKey {
+	return txscript.TweakTaprootPrivKey(*privKey, []byte{})
+}
+
+// AccountAddress
+// a regular (non SP) receive address of one of the accounts
+type AccountAddress struct {
+	Address    string         `json:"address"`
+	PkScript   []byte         `json:"pk_script"`
+	ScriptType ScriptType     `json:"script_type"`
+	Path       DerivationPath `json:"path"`
+	Comment    string         `json:"comment,omitempty"`
+}
+
+// GenerateTaprootAddress
+// derives the next BIP 86 receive address and adds it to the wallet
+func (w *Wallet) GenerateTaprootAddress(comment string) (*AccountAddress, error) {
+	address, err := w.DeriveAccountAddress(ScriptTypeP2TR, DerivationPath{Change: 0, Index: uint32(len(w.TaprootAddresses))})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	address.Comment = comment
+
+	w.TaprootAddresses = append(w.TaprootAddresses, address)
+	return address, nil
+}
+
+// DeriveAccountAddress derives the address at path without adding it to the wallet
+func (w *Wallet) DeriveAccountAddress(scriptType ScriptType, path DerivationPath) (*AccountAddress, error) {
+	pkScript, err := w.DeriveAccountPkScript(scriptType, &path)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	_, addresses, _, err := txscript.ExtractPkScriptAddrs(pkScript, ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	if len(addresses) != 1 {
+		return nil, fmt.Errorf("could not encode address for script %x", pkScript)
+	}
+
+	return &AccountAddress{
+		Address:    addresses[0].EncodeAddress(),
+		PkScript:   pkScript,
+		ScriptType: scriptType,
+		Path:       path,
+	}, nil
+}
+
+// FindAccountAddressByPkScript returns nil if pkScript does not belong to a tracked account address
+func (w *Wallet) FindAccountAddressByPkScript(pkScript []byte) *AccountAddress {
+	for _, address := range w.TaprootAddresses {
+		if bytes.Equal(address.PkScript, pkScript) {
+			return address
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package src
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/setavenger/go-bip352"
+)
+
+// AddressInfo
+// the decoded content of an address. If IsValid is false, Error holds the reason and the other fields might be empty.
+type AddressInfo struct {
+	Address            string
+	IsValid            bool
+	Error              string
+	IsSilentPayment    bool
+	Network            string // name of the chaincfg.Params the address belongs to, for SP addresses on test networks "testnet"
+	IsForWalletNetwork bool
+
+	// silent payment addresses only
+	Version     uint8
+	ScanPubKey  []byte
+	SpendPubKey []byte
+	LabelM      *uint32 // nil for the base address and foreign addresses
+
+	// regular addresses only
+	ScriptType string // script class as named by txscript
+	PkScript   []byte
+
+	IsMine  bool
+	Comment string // label comment or comment of the account address
+}
+
+// InspectAddress
+// decodes address and checks whether it belongs to the wallet. Decoding errors are reported in AddressInfo.Error.
+func (w *Wallet) InspectAddress(address string, chainParams *chaincfg.Params) *AddressInfo {
+	info := &AddressInfo{Address: address}
+
+	var err error
+	lowerAddress := strings.ToLower(address)
+	if strings.HasPrefix(lowerAddress, "sp1") || strings.HasPrefix(lowerAddress, "tsp1") {
+		err = w.inspectSilentPaymentAddress(info, chainParams)
+	} else {
+		err = w.inspectRegularAddress(info, chainParams)
+	}
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+
+	info.IsValid = true
+	return info
+}
+
+func (w *Wallet) inspectSilentPaymentAddress(info *AddressInfo, chainParams *chaincfg.Params) error {
+	info.IsSilentPayment = true
+
+	mainnet := !strings.HasPrefix(strings.ToLower(info.Address), "tsp")
+	_, data, version, err := bip352.DecodeSilentPaymentAddress(info.Address, mainnet)
+	if err != nil {
+		return err
+	}
+	// BIP 352: v31 is reserved, later versions can append data which v0 wallets ignore
+	switch {
+	case version == 31:
+		return fmt.Errorf("unsupported silent payment version %d", version)
+	case version == 0 && len(data) != 66, len(data) < 66:
+		return fmt.Errorf("invalid silent payment data length %d", len(data))
+	}
+
+	info.Version = version
+	info.ScanPubKey = data[:33]
+	info.SpendPubKey = data[33:66]
+	if mainnet {
+		info.Network = chaincfg.MainNetParams.Name
+		info.IsForWalletNetwork = chainParams.Name == chaincfg.MainNetParams.Name
+	} else {
+		info.Network = "testnet"
+		info.IsForWalletNetwork = chainParams.Name != chaincfg.MainNetParams.Name
+	}
+
+	if !bytes.Equal(info.ScanPubKey, w.PubKeyScan[:]) {
+		return nil
+	}
+	if bytes.Equal(info.SpendPubKey, w.PubKeySpend[:]) {
+		info.IsMine = true
+		info.Comment = StandardAddressComment
+		return nil
+	}
+	for _, label := range w.LabelsMapping {
+		labelledSpendPubKey, err := bip352.CreateLabelledSpendPubKey(w.PubKeySpend, label.PubKey)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(info.SpendPubKey, labelledSpendPubKey[:]) {
+			m := label.M
+			info.IsMine = true
+			info.LabelM = &m
+			info.Comment = label.Comment
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (w *Wallet) inspectRegularAddress(info *AddressInfo, chainParams *chaincfg.Params) error {
+	decoded, err := btcutil.DecodeAddress(info.Address, chainParams)
+	if err == nil && decoded.IsForNet(chainParams) {
+		info.Network = chainParams.Name
+		info.IsForWalletNetwork = true
+	} else {
+		// decode for its own network to still show the script
+		network := findAddressNetwork(info.Address)
+		if network == nil {
+			if err == nil {
+				err = fmt.Errorf("address %s does not belong to a known network", info.Address)
+			}
+			return err
+		}
+		info.Network = network.Name
+		decoded, err = btcutil.DecodeAddress(info.Address, network)
+		if err != nil {
+			return err
+		}
+	}
+
+	info.PkScript, err = txscript.PayToAddrScript(decoded)
+	if err != nil {
+		return err
+	}
+	info.ScriptType = txscript.GetScriptClass(info.PkScript).String()
+
+	if !info.IsForWalletNetwork {
+		return nil
+	}
+	if address := w.FindAccountAddressByPkScript(info.PkScript); address != nil {
+		info.IsMine = true
+		info.Comment = address.Comment
+		return nil
+	}
+	if w.HasAccountKeys() {
+		_, _, err = w.FindDerivationPath(info.PkScript, AccountGapLimit)
+		info.IsMine = err == nil
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package src
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestInspectAddress(t *testing.T) {
+	keys, err := KeysFromMnemonic(testData[0].mnemonic, testData[0].passphrase)
+	if err != nil {
+		t.Errorf("error deriving keys: %v", err)
+		return
+	}
+
+	wallet := NewWallet(0)
+	wallet.LoadKeys(keys.ScanSecretKey, keys.SpendSecretKey)
+	err = wallet.LoadAccountKeys(keys)
+	if err != nil {
+		t.Errorf("error loading account keys: %v", err)
+		return
+	}
+
+	address, err := wallet.GenerateAddress()
+	if err != nil {
+		t.Errorf("error generating address: %v", err)
+		return
+	}
+	label, err := wallet.GenerateNewLabel("donations")
+	if err != nil {
+		t.Errorf("error generating label: %v", err)
+		return
+	}
+
+	info := wallet.InspectAddress(address, ChainParams)
+	if !info.IsValid || !info.IsSilentPayment || !info.IsMine || info.LabelM != nil {
+		t.Errorf("own address not detected: %+v", info)
+	}
+
+	info = wallet.InspectAddress(label.Address, ChainParams)
+	if !info.IsValid || !info.IsMine || info.LabelM == nil || *info.LabelM != label.M || info.Comment != "donations" {
+		t.Errorf("label not detected: %+v", info)
+	}
+
+	// first BIP 84 receive address of the test mnemonic
+	info = wallet.InspectAddress("bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", ChainParams)
+	if !info.IsValid || info.IsSilentPayment || !info.IsMine || info.ScriptType != "witness_v0_keyhash" {
+		t.Errorf("account address not detected: %+v", info)
+	}
+
+	// foreign address
+	info = wallet.InspectAddress("bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq", ChainParams)
+	if !info.IsValid || info.IsMine || !info.IsForWalletNetwork || info.ScriptType != "witness_v0_keyhash" {
+		t.Errorf("foreign address decoded wrong: %+v", info)
+	}
+
+	info = wallet.InspectAddress("tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", ChainParams)
+	if !info.IsValid || info.IsForWalletNetwork || info.Network != chaincfg.TestNet3Params.Name {
+		t.Errorf("network mismatch not detected: %+v", info)
+	}
+
+	info = wallet.InspectAddress("bc1qinvalid", ChainParams)
+	if info.IsValid || info.Error == "" {
+		t.Errorf("invalid address not rejected: %+v", info)
+	}
+}
@@ -0,0 +1,72 @@
+package src
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	BroadcastBackendElectrum = "electrum"
+	BroadcastBackendBitcoind = "bitcoind"
+	BroadcastBackendEsplora  = "esplora"
+	BroadcastBackendIndexer  = "indexer"
+)
+
+// BroadcastBackend
+// one backend transactions are broadcast through. Backends are tried in the configured order.
+type BroadcastBackend struct {
+	Type string `mapstructure:"type"`
+	// Url of the bitcoind RPC or the esplora API, the indexer backend uses BlindBitServerAddress
+	Url      string `mapstructure:"url"`
+	User     string `mapstructure:"user"`     // bitcoind only
+	Password string `mapstructure:"password"` // bitcoind only
+	// Tor the electrum and indexer backends use the tor settings of the electrum and indexer connections
+	Tor          bool   `mapstructure:"tor"`
+	TorProxyHost string `mapstructure:"tor_proxy_host"`
+}
+
+// loadBroadcastBackends
+// reads the backends from the config. If none are configured electrum is used if it is available.
+func loadBroadcastBackends() ([]BroadcastBackend, error) {
+	var backends []BroadcastBackend
+	err := viper.UnmarshalKey("broadcast.backends", &backends)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(backends) == 0 && UseElectrum {
+		return []BroadcastBackend{{Type: BroadcastBackendElectrum}}, nil
+	}
+
+	for i := range backends {
+		backend := &backends[i]
+		switch backend.Type {
+		case BroadcastBackendElectrum:
+			if !UseElectrum {
+				return nil, fmt.Errorf("broadcast backend %d: electrum is not configured", i+1)
+			}
+		case BroadcastBackendIndexer:
+			if BlindBitServerAddress == "" || IndexerProtocol != IndexerProtocolHTTP {
+				return nil, fmt.Errorf("broadcast backend %d: needs an indexer accessed via http", i+1)
+			}
+			// the indexer is reached the same way as for scanning, a clear net request could leak an onion indexer
+			backend.Tor = BlindBitTorProxyHost != ""
+			backend.TorProxyHost = BlindBitTorProxyHost
+		case BroadcastBackendBitcoind, BroadcastBackendEsplora:
+			if backend.Url == "" {
+				return nil, fmt.Errorf("broadcast backend %d: %s needs a url", i+1, backend.Type)
+			}
+		default:
+			return nil, fmt.Errorf("broadcast backend %d: unknown type %q", i+1, backend.Type)
+		}
+
+		if !backend.Tor {
+			backend.TorProxyHost = ""
+		} else if backend.TorProxyHost == "" {
+			backend.TorProxyHost = "127.0.0.1:9050"
+		}
+	}
+
+	return backends, nil
+}
@@ -16,15 +16,19 @@ import (
 )
 
 // FeeRateCoinSelector
-// Custom CoinSelector implementation. Selects according to a given fee rate. Focused on taproot-only inputs.
+// Custom CoinSelector implementation. Selects according to a given fee rate. Focused on taproot inputs.
 // Needs the OwnedUTXOs to contain at least the Amount of the src.OwnedUTXO.
 // The function will fail if not enough value could be added together.
 // Other data in the OwnedUTXOs is preserved.
-// At the moment it is always assumed that we receive a taproot input.
+// The size of an input depends on the ScriptType of the UTXO (taproot key-path or P2WPKH).
+// RequiredUTXOs are always selected (before any of the OwnedUTXOs), e.g. the inputs of a transaction that is replaced.
+// VByteEstimate holds the estimated size of the transaction after a successful selection.
 type FeeRateCoinSelector struct {
 	OwnedUTXOs      src.UtxoCollection
+	RequiredUTXOs   src.UtxoCollection
 	MinChangeAmount uint64
 	Recipients      []*src.Recipient
+	VByteEstimate   float64
 }
 
 // Length in bytes without witness discount
@@ -44,6 +48,9 @@ const (
 	// TrWitnessDataLen already discounted by 0.25 complete length (varInt + actual data)
 	TrWitnessDataLen float64 = 16.25
 
+	// P2WPKHWitnessDataLen already discounted by 0.25 (varInt + signature with length + compressed pubKey with length)
+	P2WPKHWitnessDataLen float64 = 27
+
 	OutputValueLen float64 = 8
 
 	WitnessCountLen float64 = 1 // todo is a varInt
@@ -63,14 +70,62 @@ func NewFeeRateCoinSelector(utxos src.UtxoCollection, minChangeAmount uint64, re
 
 // CoinSelect
 // returns the utxos to select and the change amount in order to achieve the desired fee rate.
+// The fee rate is given in sats/vByte. Use CoinSelectMilliSats for fractional fee rates.
 // NOTE: A change amount is always added.
 // todo don't require a change amount and just increase fee if difference is below a certain threshold
 func (s *FeeRateCoinSelector) CoinSelect(feeRate uint32) (src.UtxoCollection, uint64, error) {
-	// todo should we somehow expose the resulting vBytes for later analysis?
-	// todo reduce complexity in this function
 	if feeRate < 1 {
 		return nil, 0, src.ErrInvalidFeeRate
 	}
+	return s.CoinSelectMilliSats(uint64(feeRate) * 1000)
+}
+
+// CoinSelectMilliSats
+// same as CoinSelect but the fee rate is given in millisats/vByte (1_500 = 1.5 sats/vByte).
+func (s *FeeRateCoinSelector) CoinSelectMilliSats(feeRateMilliSats uint64) (src.UtxoCollection, uint64, error) {
+	if feeRateMilliSats < 1 {
+		return nil, 0, src.ErrInvalidFeeRate
+	}
+	return s.coinSelect(func(vByte float64) uint64 {
+		return NeededFeeAbsolutSatsMilliSats(vByte, feeRateMilliSats)
+	})
+}
+
+// CoinSelectAbsoluteFee
+// returns the utxos to select and the change amount such that the transaction pays exactly absoluteFee sats.
+// The size of the transaction has no influence on the fee. The change absorbs the difference.
+func (s *FeeRateCoinSelector) CoinSelectAbsoluteFee(absoluteFee uint64) (src.UtxoCollection, uint64, error) {
+	if absoluteFee < 1 {
+		return nil, 0, src.ErrInvalidAbsoluteFee
+	}
+	return s.coinSelect(func(_ float64) uint64 {
+		return absoluteFee
+	})
+}
+
+// CoinSelectPackage
+// selects coins for a child transaction (CPFP) such that the child and its unconfirmed ancestors
+// together reach feeRateMilliSats. ancestorVSize and ancestorFee are the summed sizes and fees of the ancestors.
+// The child itself never pays less than feeRateMilliSats.
+func (s *FeeRateCoinSelector) CoinSelectPackage(feeRateMilliSats, ancestorVSize, ancestorFee uint64) (src.UtxoCollection, uint64, error) {
+	if feeRateMilliSats < 1 {
+		return nil, 0, src.ErrInvalidFeeRate
+	}
+	return s.coinSelect(func(vByte float64) uint64 {
+		childFee := NeededFeeAbsolutSatsMilliSats(vByte, feeRateMilliSats)
+		packageFee := NeededFeeAbsolutSatsMilliSats(vByte+float64(ancestorVSize), feeRateMilliSats)
+		if packageFee < ancestorFee+childFee {
+			return childFee
+		}
+		return packageFee - ancestorFee
+	})
+}
+
+// coinSelect
+// neededFee returns the fee in sats for a transaction of the given size in vBytes.
+func (s *FeeRateCoinSelector) coinSelect(neededFee func(vByte float64) uint64) (src.UtxoCollection, uint64, error) {
+	// todo reduce complexity in this function
+
 	// track vBytes of the transaction
 	var vByte float64 // todo make sure we don't face any decimal imprecision
 
@@ -107,8 +162,9 @@ func (s *FeeRateCoinSelector) CoinSelect(feeRate uint32) (src.UtxoCollection, ui
 	var sumSelectedInputsAmounts uint64
 	//var potentialVBytes = vByte // tracks a potential increase before actually adding to the main vByte tracking
 
-	for i, utxo := range s.OwnedUTXOs {
-		_ = i
+	candidates := append(append(src.UtxoCollection{}, s.RequiredUTXOs...), s.OwnedUTXOs...)
+
+	for i, utxo := range candidates {
 		// we check that the sum of selected input amounts exceeds the (target Value + fees + (min. change))
 		selectedInputs = append(selectedInputs, utxo)
 		sumSelectedInputsAmounts += utxo.Amount
@@ -120,17 +176,22 @@ func (s *FeeRateCoinSelector) CoinSelect(feeRate uint32) (src.UtxoCollection, ui
 			vByte += OutputValueLen + float64(wire.VarIntSerializeSize(uint64(ScriptPubKeyTaprootLen))) + float64(ScriptPubKeyTaprootLen)
 		}
 
-		// outpoint size
-		vByte += TrInputOutpointLen
-		vByte += TrWitnessDataLen
+		vByte += InputVByte(utxo)
+
+		if i < len(s.RequiredUTXOs)-1 {
+			// all required utxos have to be selected before we can stop
+			continue
+		}
 
 		// todo also check that the fee rate is as we want it
-		if sumSelectedInputsAmounts > sumTargetAmount+NeededFeeAbsolutSats(vByte, feeRate) {
-			if sumSelectedInputsAmounts-(sumTargetAmount+NeededFeeAbsolutSats(vByte, feeRate)) < s.MinChangeAmount {
+		fee := neededFee(vByte)
+		if sumSelectedInputsAmounts > sumTargetAmount+fee {
+			if sumSelectedInputsAmounts-(sumTargetAmount+fee) < s.MinChangeAmount {
 				continue
 			}
 			// todo account that change was considered in the vByte tx size
-			return selectedInputs, sumSelectedInputsAmounts - (sumTargetAmount + NeededFeeAbsolutSats(vByte, feeRate)), err
+			s.VByteEstimate = vByte
+			return selectedInputs, sumSelectedInputsAmounts - (sumTargetAmount + fee), err
 		}
 	}
 
@@ -171,6 +232,23 @@ func extractPkScriptsFromRecipients(recipients []*src.Recipient) ([]int, error)
 	return pkScriptLens, nil
 }
 
+// InputVByte
+// returns the size of an input including its witness. P2WPKH and taproot inputs have the same outpoint size.
+func InputVByte(utxo *src.OwnedUTXO) float64 {
+	switch utxo.ScriptType {
+	case src.ScriptTypeP2WPKH:
+		return TrInputOutpointLen + P2WPKHWitnessDataLen
+	default:
+		return TrInputOutpointLen + TrWitnessDataLen
+	}
+}
+
 func NeededFeeAbsolutSats(vByte float64, feeRate uint32) uint64 {
 	return uint64(math.Ceil(vByte * float64(feeRate)))
 }
+
+// NeededFeeAbsolutSatsMilliSats
+// same as NeededFeeAbsolutSats but takes the fee rate in millisats/vByte. The result is rounded up to the next sat.
+func NeededFeeAbsolutSatsMilliSats(vByte float64, feeRateMilliSats uint64) uint64 {
+	return uint64(math.Ceil(vByte * float64(feeRateMilliSats) / 1000))
+}
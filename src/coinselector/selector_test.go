@@ -510,3 +510,213 @@ func TestFeeRateCoinSelector_CoinSelect(t *testing.T) {
 
 	}
 }
+
+func TestFeeRateCoinSelector_CoinSelectMilliSats(t *testing.T) {
+	src.ChainParams = &chaincfg.MainNetParams
+
+	utxos := src.UtxoCollection{{Amount: 20_000}, {Amount: 40_000}, {Amount: 60_000}}
+	recipients := []*src.Recipient{
+		{
+			Address: "bc1qua7e852suw0p74e2lzxwmk2tw8fd2zuzexc866",
+			Amount:  5_000,
+		},
+	}
+
+	// same transaction as "Simple case" (142 vByte)
+	var fractionalCases = []struct {
+		feeRateMilliSats uint64
+		expectedFee      uint64
+	}{
+		{feeRateMilliSats: 1_000, expectedFee: 142},
+		{feeRateMilliSats: 1_500, expectedFee: 213},
+		{feeRateMilliSats: 2_500, expectedFee: 355},
+		{feeRateMilliSats: 100, expectedFee: 15}, // 14.2 is rounded up
+	}
+
+	for _, testCase := range fractionalCases {
+		cs := NewFeeRateCoinSelector(utxos, 5000, recipients)
+		selectedCoins, change, err := cs.CoinSelectMilliSats(testCase.feeRateMilliSats)
+		if err != nil {
+			t.Errorf("Error: %s", err)
+			return
+		}
+		if len(selectedCoins) != 1 {
+			t.Errorf("Error: wrong number of coins selected %d != %d", len(selectedCoins), 1)
+			return
+		}
+		fee := selectedCoins[0].Amount - uint64(recipients[0].Amount) - change
+		if fee != testCase.expectedFee {
+			t.Errorf("Error: fee incorrect for %d msat/vByte: %d != %d", testCase.feeRateMilliSats, fee, testCase.expectedFee)
+			return
+		}
+	}
+
+	cs := NewFeeRateCoinSelector(utxos, 5000, recipients)
+	_, _, err := cs.CoinSelectMilliSats(0)
+	if !errors.Is(err, src.ErrInvalidFeeRate) {
+		t.Errorf("expected error %v but got %v", src.ErrInvalidFeeRate, err)
+		return
+	}
+}
+
+func TestFeeRateCoinSelector_CoinSelectAbsoluteFee(t *testing.T) {
+	src.ChainParams = &chaincfg.MainNetParams
+
+	utxos := src.UtxoCollection{{Amount: 20_000}, {Amount: 40_000}, {Amount: 60_000}}
+
+	cs := NewFeeRateCoinSelector(utxos, 5000, []*src.Recipient{
+		{
+			Address: "bc1qua7e852suw0p74e2lzxwmk2tw8fd2zuzexc866",
+			Amount:  5_000,
+		},
+	})
+	selectedCoins, change, err := cs.CoinSelectAbsoluteFee(500)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 1 || change != 14_500 {
+		t.Errorf("Error: expected 1 coin and change 14500, got %d coins and change %d", len(selectedCoins), change)
+		return
+	}
+
+	// needs a second input to keep the min change amount
+	cs = NewFeeRateCoinSelector(utxos, 5000, []*src.Recipient{
+		{
+			Address: "bc1qua7e852suw0p74e2lzxwmk2tw8fd2zuzexc866",
+			Amount:  54_000,
+		},
+	})
+	selectedCoins, change, err = cs.CoinSelectAbsoluteFee(1_000)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 2 || change != 5_000 {
+		t.Errorf("Error: expected 2 coins and change 5000, got %d coins and change %d", len(selectedCoins), change)
+		return
+	}
+
+	_, _, err = cs.CoinSelectAbsoluteFee(0)
+	if !errors.Is(err, src.ErrInvalidAbsoluteFee) {
+		t.Errorf("expected error %v but got %v", src.ErrInvalidAbsoluteFee, err)
+		return
+	}
+}
+
+func TestFeeRateCoinSelector_RequiredUTXOs(t *testing.T) {
+	src.ChainParams = &chaincfg.MainNetParams
+
+	recipients := []*src.Recipient{
+		{
+			Address: "bc1qua7e852suw0p74e2lzxwmk2tw8fd2zuzexc866",
+			Amount:  5_000,
+		},
+	}
+
+	// all required utxos are selected even though the first one would suffice
+	cs := NewFeeRateCoinSelector(src.UtxoCollection{{Amount: 60_000}}, 5000, recipients)
+	cs.RequiredUTXOs = src.UtxoCollection{{Amount: 20_000}, {Amount: 20_000}}
+	selectedCoins, change, err := cs.CoinSelect(1)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 2 || change != 34_800 {
+		t.Errorf("Error: expected 2 coins and change 34800, got %d coins and change %d", len(selectedCoins), change)
+		return
+	}
+
+	// required utxos are not enough, owned utxos are added
+	cs = NewFeeRateCoinSelector(src.UtxoCollection{{Amount: 60_000}}, 5000, recipients)
+	cs.RequiredUTXOs = src.UtxoCollection{{Amount: 1_000}}
+	selectedCoins, change, err = cs.CoinSelect(1)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 2 || change != 55_800 {
+		t.Errorf("Error: expected 2 coins and change 55800, got %d coins and change %d", len(selectedCoins), change)
+		return
+	}
+	if selectedCoins[0].Amount != 1_000 {
+		t.Errorf("Error: required utxo has to be selected first")
+		return
+	}
+}
+
+func TestFeeRateCoinSelector_CoinSelectPackage(t *testing.T) {
+	src.ChainParams = &chaincfg.MainNetParams
+
+	// a CPFP child has no recipients, everything goes to change
+	cs := NewFeeRateCoinSelector(src.UtxoCollection{{Amount: 60_000}}, 5000, nil)
+	cs.RequiredUTXOs = src.UtxoCollection{{Amount: 20_000}}
+
+	// child of 111 vByte and parent of 200 vByte paying 200 sats at 10 sats/vByte: 3110 - 200
+	selectedCoins, change, err := cs.CoinSelectPackage(10_000, 200, 200)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 1 || change != 17_090 {
+		t.Errorf("Error: expected 1 coin and change 17090, got %d coins and change %d", len(selectedCoins), change)
+		return
+	}
+
+	// the parent already pays enough, the child still pays the fee rate for itself
+	selectedCoins, change, err = cs.CoinSelectPackage(10_000, 200, 5_000)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 1 || change != 18_890 {
+		t.Errorf("Error: expected 1 coin and change 18890, got %d coins and change %d", len(selectedCoins), change)
+		return
+	}
+
+	// the required utxo can't pay for the parent, a second input is added
+	selectedCoins, _, err = cs.CoinSelectPackage(100_000, 200, 200)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 2 {
+		t.Errorf("Error: expected 2 coins, got %d coins", len(selectedCoins))
+		return
+	}
+}
+
+func TestFeeRateCoinSelector_MixedInputs(t *testing.T) {
+	src.ChainParams = &chaincfg.MainNetParams
+
+	recipients := []*src.Recipient{
+		{
+			Address: "bc1qua7e852suw0p74e2lzxwmk2tw8fd2zuzexc866",
+			Amount:  5_000,
+		},
+	}
+
+	// 10.5 overhead + 31 recipient + 0.25 witness count + 43 change + 41 outpoint + 27 P2WPKH witness
+	cs := NewFeeRateCoinSelector(src.UtxoCollection{{Amount: 20_000, ScriptType: src.ScriptTypeP2WPKH}}, 5000, recipients)
+	selectedCoins, change, err := cs.CoinSelect(1)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if len(selectedCoins) != 1 || cs.VByteEstimate != 152.75 || change != 14_847 {
+		t.Errorf("Error: expected 1 coin, 152.75 vByte and change 14847, got %d coins, %f vByte and change %d", len(selectedCoins), cs.VByteEstimate, change)
+		return
+	}
+
+	// the same input as taproot is smaller
+	cs = NewFeeRateCoinSelector(src.UtxoCollection{{Amount: 20_000, ScriptType: src.ScriptTypeP2TR}}, 5000, recipients)
+	_, change, err = cs.CoinSelect(1)
+	if err != nil {
+		t.Errorf("Error: %s", err)
+		return
+	}
+	if cs.VByteEstimate != 142 || change != 14_858 {
+		t.Errorf("Error: expected 142 vByte and change 14858, got %f vByte and change %d", cs.VByteEstimate, change)
+		return
+	}
+}
@@ -0,0 +1,250 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/database"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+	"github.com/setavenger/go-bip352"
+)
+
+// AccountDiscoveryGap number of unused addresses after which the discovery of used BIP 86 addresses stops
+const AccountDiscoveryGap = 20
+
+// NewTaprootAddress
+// derives the next BIP 86 receive address and starts watching it via electrum
+func (d *Daemon) NewTaprootAddress(comment string) (*src.AccountAddress, error) {
+	if !d.Wallet.HasAccountKeys() {
+		return nil, src.ErrNoAccountKeys
+	}
+
+	address, err := d.Wallet.GenerateTaprootAddress(comment)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	if d.accountNotifChan != nil {
+		err = d.watchAccountAddress(address)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	}
+
+	return address, nil
+}
+
+// startAccountWatcher
+// discovers used BIP 86 addresses (relevant after a recovery) and subscribes to all of them via electrum.
+// Notifications are handled in ContinuousScan.
+func (d *Daemon) startAccountWatcher() error {
+	if !src.UseElectrum || !d.Wallet.HasAccountKeys() {
+		return nil
+	}
+
+	err := d.discoverTaprootAddresses()
+	if errors.Is(err, src.ErrElectrumUnavailable) {
+		// the known addresses are still watched, the discovery runs again on the next start
+		logging.WarningLogger.Printf("address discovery skipped: %s\n", err)
+	} else if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	d.accountNotifChan = d.Electrum.ScripthashNotifications()
+
+	// subscribing pushes the current status into the notification channel which is only read once ContinuousScan runs.
+	// Addresses which fail to subscribe now are subscribed once the Electrum connection is renewed.
+	go func() {
+		for _, address := range d.Wallet.TaprootAddresses {
+			err := d.watchAccountAddress(address)
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (d *Daemon) watchAccountAddress(address *src.AccountAddress) error {
+	scriptHash := utils.ConvertPkScriptToScriptHash(address.PkScript)
+
+	d.accountScriptsMu.Lock()
+	d.accountScripts[scriptHash] = address
+	d.accountScriptsMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return d.Electrum.WatchScripthash(ctx, scriptHash)
+}
+
+// discoverTaprootAddresses
+// adds all addresses up to the last one with a history, stops after AccountDiscoveryGap unused addresses
+func (d *Daemon) discoverTaprootAddresses() error {
+	var unused int
+	for index := uint32(len(d.Wallet.TaprootAddresses)); unused < AccountDiscoveryGap; index++ {
+		address, err := d.Wallet.DeriveAccountAddress(src.ScriptTypeP2TR, src.DerivationPath{Change: 0, Index: index})
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+
+		client, err := d.Electrum.Client()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		history, err := client.GetHistory(ctx, utils.ConvertPkScriptToScriptHash(address.PkScript))
+		cancel()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+
+		if len(history) == 0 {
+			unused++
+			continue
+		}
+
+		// all addresses before a used one are added as well
+		for uint32(len(d.Wallet.TaprootAddresses)) <= index {
+			_, err = d.Wallet.GenerateTaprootAddress("")
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				return err
+			}
+		}
+		unused = 0
+	}
+	return nil
+}
+
+// syncAccountScript
+// updates the UTXOs of an account address with the unspent outputs electrum knows for scriptHash
+func (d *Daemon) syncAccountScript(scriptHash string) error {
+	d.accountScriptsMu.Lock()
+	address, ok := d.accountScripts[scriptHash]
+	d.accountScriptsMu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	client, err := d.Electrum.Client()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	unspent, err := client.ListUnspent(ctx, scriptHash)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	listed := make(map[[36]byte]struct{}, len(unspent))
+	var newUTXOs src.UtxoCollection
+	for _, result := range unspent {
+		var txHash *chainhash.Hash
+		txHash, err = chainhash.NewHashFromStr(result.Hash)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		utxo := &src.OwnedUTXO{
+			Txid:           bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(txHash[:])),
+			Vout:           result.Position,
+			Amount:         result.Value,
+			PubKey:         bip352.ConvertToFixedLength32(address.PkScript[2:]),
+			State:          src.StateUnconfirmed,
+			ScriptType:     address.ScriptType,
+			PkScript:       address.PkScript,
+			DerivationPath: &src.DerivationPath{Change: address.Path.Change, Index: address.Path.Index},
+		}
+		if result.Height > 0 {
+			utxo.State = src.StateUnspent
+			utxo.Timestamp, err = d.fetchBlockTimestamp(result.Height)
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				return err
+			}
+		}
+
+		var outpoint [36]byte
+		outpoint, err = utxo.SerialiseToOutpoint()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		listed[outpoint] = struct{}{}
+
+		var existing *src.OwnedUTXO
+		existing, err = d.Wallet.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		if existing == nil {
+			newUTXOs = append(newUTXOs, utxo)
+			continue
+		}
+		if existing.State == src.StateUnconfirmed && utxo.State == src.StateUnspent {
+			existing.State = src.StateUnspent
+			existing.Timestamp = utxo.Timestamp
+		}
+	}
+
+	// outputs which are not listed anymore were spent, they are marked as spent once the block is scanned
+	for _, utxo := range d.Wallet.UTXOs {
+		if !bytes.Equal(utxo.PkScript, address.PkScript) {
+			continue
+		}
+		if utxo.State != src.StateUnspent && utxo.State != src.StateUnconfirmed {
+			continue
+		}
+		var outpoint [36]byte
+		outpoint, err = utxo.SerialiseToOutpoint()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		if _, ok = listed[outpoint]; !ok {
+			utxo.State = src.StateUnconfirmedSpent
+		}
+	}
+
+	if len(newUTXOs) == 0 {
+		return nil
+	}
+
+	err = d.Wallet.AddUTXOs(newUTXOs)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	logging.InfoLogger.Printf("Found %d new outputs on %s\n", len(newUTXOs), address.Address)
+
+	if d.Locked || d.Password == nil {
+		return nil
+	}
+	err = database.WriteToDB(src.PathDbWallet, d.Wallet, d.Password)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/coinselector"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+	"github.com/setavenger/go-bip352"
+)
+
+// CpfpBump
+// creates a child transaction which spends our outputs of the unconfirmed transaction txid back to the change label.
+// The fee of the child is chosen such that parent and child together pay feeRateMilliSats.
+// Additional inputs are only added if our outputs of the parent can't cover the fee.
+// The parent is fetched via electrum, unless it was sent by this wallet, and scanned for outputs belonging to this wallet.
+// Outputs already known as unconfirmed are reused. Nothing changes in the wallet until the child is broadcast via BroadcastRawTx,
+// then the other outputs are added to the wallet and all of them are marked as spent_unconfirmed.
+func (d *Daemon) CpfpBump(txid string, feeRateMilliSats uint64) (*src.OutgoingTransaction, error) {
+	if feeRateMilliSats < 1 {
+		return nil, src.ErrInvalidFeeRate
+	}
+
+	parent, err := d.fetchTransaction(txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	prevOuts, err := d.fetchPrevOuts(parent)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	var sumInputs, sumOutputs int64
+	for _, prevOut := range prevOuts {
+		sumInputs += prevOut.Value
+	}
+	for _, txOut := range parent.TxOut {
+		sumOutputs += txOut.Value
+	}
+	parentFee := uint64(sumInputs - sumOutputs)
+	parentVSize := uint64(mempool.GetTxVirtualSize(btcutil.NewTx(parent)))
+
+	if parentFee*1000/parentVSize >= feeRateMilliSats {
+		err = fmt.Errorf("%w: %d msat/vByte >= %d msat/vByte", src.ErrPackageFeeRateAlreadyReached, parentFee*1000/parentVSize, feeRateMilliSats)
+		return nil, err
+	}
+
+	parentOutputs, err := d.scanTransaction(parent, prevOuts)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	if len(parentOutputs) == 0 {
+		return nil, src.ErrNoOwnedOutputsInTransaction
+	}
+
+	// outputs of a parent broadcast by this daemon are already known as unconfirmed
+	var newOutputs src.UtxoCollection
+	for i, utxo := range parentOutputs {
+		var outpoint [36]byte
+		outpoint, err = utxo.SerialiseToOutpoint()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		var existing *src.OwnedUTXO
+		existing, err = d.Wallet.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		switch {
+		case existing == nil:
+			newOutputs = append(newOutputs, utxo)
+		case existing.State == src.StateUnconfirmed:
+			parentOutputs[i] = existing
+		case existing.State == src.StateUnconfirmedSpent:
+			return nil, fmt.Errorf("output %x:%d of %s is already spent by an unconfirmed transaction", utxo.Txid, utxo.Vout, txid)
+		default:
+			return nil, fmt.Errorf("transaction %s is already confirmed", txid)
+		}
+	}
+
+	// the child only pays back to our change label
+	selector := coinselector.NewFeeRateCoinSelector(d.Wallet.GetFreeUTXOs(false), uint64(src.MinChangeAmount), nil)
+	selector.RequiredUTXOs = parentOutputs
+
+	child, _, err := d.createTransaction(selector, src.FeeTarget{
+		FeeRateMilliSats: feeRateMilliSats,
+		AncestorVSize:    parentVSize,
+		AncestorFee:      parentFee,
+	}, "")
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	d.addCreatedTransaction(child, false, newOutputs)
+
+	logging.InfoLogger.Printf("Created CPFP child %s for %s (package fee rate: %d msat/vByte)\n", child.Txid, txid, (parentFee+child.Fee)*1000/(parentVSize+uint64(child.VSize)))
+
+	return child, nil
+}
+
+// scanTransaction
+// returns the outputs of tx which belong to this wallet. prevOuts has to contain the spent outputs of all inputs.
+// The returned UTXOs are in the StateUnconfirmed.
+func (d *Daemon) scanTransaction(tx *wire.MsgTx, prevOuts map[wire.OutPoint]*wire.TxOut) (src.UtxoCollection, error) {
+	tweak, err := utils.ComputeTweak(tx, prevOuts)
+	if err != nil {
+		if errors.Is(err, bip352.ErrNoEligibleVins) {
+			return nil, nil
+		}
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	// SP outputs are always taproot outputs
+	var txOutputs [][32]byte
+	outputIndex := make(map[[32]byte]int)
+	for i, txOut := range tx.TxOut {
+		if len(txOut.PkScript) != 34 || txOut.PkScript[0] != 0x51 || txOut.PkScript[1] != 0x20 {
+			continue
+		}
+		output := bip352.ConvertToFixedLength32(txOut.PkScript[2:])
+		txOutputs = append(txOutputs, output)
+		outputIndex[output] = i
+	}
+	if len(txOutputs) == 0 {
+		return nil, nil
+	}
+
+	// otherwise change will not be found
+	labelsToCheck := append([]*bip352.Label{d.Wallet.ChangeLabel}, d.Wallet.Labels...)
+
+	foundOutputs, err := bip352.ReceiverScanTransaction(d.Wallet.SecretKeyScan(), d.Wallet.PubKeySpend, labelsToCheck, txOutputs, tweak, nil)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	txHash := tx.TxHash()
+	var ownedUTXOs src.UtxoCollection
+	for _, foundOutput := range foundOutputs {
+		i, ok := outputIndex[foundOutput.Output]
+		if !ok {
+			err = src.ErrNoMatchForUTXO
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		ownedUTXOs = append(ownedUTXOs, &src.OwnedUTXO{
+			Txid:         bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(txHash[:])),
+			Vout:         uint32(i),
+			Amount:       uint64(tx.TxOut[i].Value),
+			PrivKeyTweak: foundOutput.SecKeyTweak,
+			PubKey:       foundOutput.Output,
+			Timestamp:    uint64(time.Now().Unix()),
+			State:        src.StateUnconfirmed,
+			Label:        foundOutput.Label,
+		})
+	}
+
+	return ownedUTXOs, nil
+}
+
+// fetchTransaction gets a (possibly unconfirmed) transaction from electrum, transactions of the wallet are known locally
+func (d *Daemon) fetchTransaction(txid string) (*wire.MsgTx, error) {
+	if outgoingTx := d.Wallet.GetOutgoingTransaction(txid); outgoingTx != nil {
+		return outgoingTx.MsgTx()
+	}
+	if !src.UseElectrum || d.Electrum == nil {
+		return nil, errors.New("electrum is needed to fetch transactions")
+	}
+	client, err := d.Electrum.Client()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rawTxHex, err := client.GetRawTransaction(ctx, txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	rawTx, err := hex.DecodeString(rawTxHex)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	var tx wire.MsgTx
+	err = tx.Deserialize(bytes.NewReader(rawTx))
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+// fetchPrevOuts fetches the outputs spent by the inputs of tx, our own UTXOs are known locally
+func (d *Daemon) fetchPrevOuts(tx *wire.MsgTx) (map[wire.OutPoint]*wire.TxOut, error) {
+	prevOuts := make(map[wire.OutPoint]*wire.TxOut, len(tx.TxIn))
+	prevTxs := make(map[string]*wire.MsgTx)
+	for _, txIn := range tx.TxIn {
+		outpoint, err := utils.SerialiseWireOutpoint(txIn.PreviousOutPoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		utxo, err := d.Wallet.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if utxo != nil {
+			prevOuts[txIn.PreviousOutPoint] = wire.NewTxOut(int64(utxo.Amount), utxo.ScriptPubKey())
+			continue
+		}
+
+		prevTxid := txIn.PreviousOutPoint.Hash.String()
+		prevTx, ok := prevTxs[prevTxid]
+		if !ok {
+			prevTx, err = d.fetchTransaction(prevTxid)
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				return nil, err
+			}
+			prevTxs[prevTxid] = prevTx
+		}
+		if int(txIn.PreviousOutPoint.Index) >= len(prevTx.TxOut) {
+			return nil, fmt.Errorf("prevout %s does not exist", txIn.PreviousOutPoint)
+		}
+		prevOuts[txIn.PreviousOutPoint] = prevTx.TxOut[txIn.PreviousOutPoint.Index]
+	}
+	return prevOuts, nil
+}
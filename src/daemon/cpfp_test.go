@@ -0,0 +1,74 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+func TestCpfpBumpBroadcastParent(t *testing.T) {
+	d, broadcaster := newTestDaemon(t)
+	addTestUTXO(t, d, "a", 100_000)
+	parent := sendTestTransaction(t, d)
+
+	// the change of the parent was added as unconfirmed by the broadcast
+	change := d.Wallet.GetUTXOsByStates(src.StateUnconfirmed)
+	if len(change) != 1 {
+		t.Fatalf("expected the change of the parent, got %d unconfirmed UTXOs", len(change))
+	}
+	utxoCount := len(d.Wallet.UTXOs)
+
+	child, err := d.CpfpBump(parent.Txid, 10_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	childTx, err := child.MsgTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(childTx.TxIn) != 1 || childTx.TxIn[0].PreviousOutPoint.Hash.String() != parent.Txid {
+		t.Errorf("child does not spend the change of the parent")
+	}
+	if change[0].State != src.StateUnconfirmed || d.Wallet.GetOutgoingTransaction(child.Txid) != nil {
+		t.Errorf("child applied to the wallet before the broadcast: change state %d", change[0].State)
+	}
+
+	broadcaster.err = errors.New("rejected")
+	_, err = d.BroadcastRawTx(child.RawTx)
+	if err == nil {
+		t.Fatal("broadcast did not fail")
+	}
+	if change[0].State != src.StateUnconfirmed || d.Wallet.GetOutgoingTransaction(child.Txid) != nil {
+		t.Errorf("child applied to the wallet although the broadcast failed: change state %d", change[0].State)
+	}
+
+	broadcaster.err = nil
+	_, err = d.BroadcastRawTx(child.RawTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change[0].State != src.StateUnconfirmedSpent {
+		t.Errorf("change not marked as spent: %d", change[0].State)
+	}
+	if outgoingTx := d.Wallet.GetOutgoingTransaction(child.Txid); outgoingTx == nil || outgoingTx.State != src.OutgoingTxPending {
+		t.Errorf("child not tracked as pending: %+v", outgoingTx)
+	}
+	// only the output of the child is new, the change was not added twice
+	if len(d.Wallet.UTXOs) != utxoCount+1 {
+		t.Errorf("%d UTXOs, expected %d", len(d.Wallet.UTXOs), utxoCount+1)
+	}
+
+	// the change is spent now
+	_, err = d.CpfpBump(parent.Txid, 20_000)
+	if err == nil {
+		t.Errorf("spent change used for a second child")
+	}
+
+	// outputs of confirmed parents can't be used
+	change[0].State = src.StateUnspent
+	_, err = d.CpfpBump(parent.Txid, 20_000)
+	if err == nil {
+		t.Errorf("confirmed parent bumped")
+	}
+}
@@ -3,6 +3,7 @@ package daemon
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/setavenger/blindbitd/pb"
 	"github.com/setavenger/blindbitd/src"
@@ -20,34 +21,54 @@ type Daemon struct {
 	ReadyChan         chan struct{} // for the startup signal; either unlocking or setting password on initial startup
 	ShutdownChan      chan struct{}
 	Mnemonic          string
-	ClientElectrum    *electrum.Client
-	ClientBlindBit    *networking.ClientBlindBit
+	Electrum          *networking.ElectrumSupervisor // nil if Electrum is not used
+	Indexer           networking.Indexer
+	Broadcasters      networking.Broadcasters
 	Wallet            *src.Wallet
 	NewBlockChan      <-chan *electrum.SubscribeHeadersResult
 	TriggerRescanChan chan uint64
+
+	ctx    context.Context // cancelled on Shutdown, aborts outstanding indexer requests
+	cancel context.CancelFunc
+
+	feeEstimates   map[uint32]*FeeEstimate // cached fee estimates by confirmation target
+	feeEstimatesMu sync.Mutex
+
+	accountNotifChan <-chan *electrum.SubscribeNotif // nil if the regular accounts are not watched
+	accountScripts   map[string]*src.AccountAddress  // scriptHash -> watched account address
+	accountScriptsMu sync.Mutex
+
+	schedules   *src.ScheduleStore
+	schedulesMu sync.Mutex
+
+	createdTxs   map[string]*createdTransaction // built transactions waiting for their broadcast, by txid
+	createdTxsMu sync.Mutex
 }
 
-func NewDaemon(wallet *src.Wallet, clientBlindBit *networking.ClientBlindBit, clientElectrum *electrum.Client) (*Daemon, error) {
+func NewDaemon(wallet *src.Wallet, indexer networking.Indexer, electrumSupervisor *networking.ElectrumSupervisor) (*Daemon, error) {
 	var channel <-chan *electrum.SubscribeHeadersResult
-	var err error
-	if src.UseElectrum {
-		channel, err = clientElectrum.SubscribeHeaders(context.Background())
-		if err != nil {
-			logging.ErrorLogger.Println(err)
-			return nil, err
-		}
+	if electrumSupervisor != nil {
+		channel = electrumSupervisor.NewBlocks()
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
 	daemon := Daemon{
 		Status:            pb.Status_STATUS_UNSPECIFIED,
 		Wallet:            wallet,
-		ClientBlindBit:    clientBlindBit,
-		ClientElectrum:    clientElectrum,
+		Indexer:           indexer,
+		Electrum:          electrumSupervisor,
+		Broadcasters:      networking.CreateBroadcasters(src.BroadcastBackends, electrumSupervisor),
 		Locked:            true,
 		ReadyChan:         make(chan struct{}),
 		ShutdownChan:      make(chan struct{}),
 		NewBlockChan:      channel,
 		TriggerRescanChan: make(chan uint64),
+		feeEstimates:      make(map[uint32]*FeeEstimate),
+		accountScripts:    make(map[string]*src.AccountAddress),
+		schedules:         &src.ScheduleStore{},
+		createdTxs:        make(map[string]*createdTransaction),
+		ctx:               ctx,
+		cancel:            cancel,
 	}
 	return &daemon, nil
 }
@@ -58,12 +79,21 @@ func (d *Daemon) Run() error {
 	// first we sync up and then we scan continuously
 	err := d.SyncToTip(0)
 	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return err
+		if d.ctx.Err() != nil {
+			return nil
+		}
+		// an unreachable indexer should not stop the daemon, ContinuousScan catches up once it is back
+		logScanFailure(err)
 	}
 
 	logging.InfoLogger.Println("Balance:", d.Wallet.FreeBalance())
 
+	err = d.startAccountWatcher()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
 	// todo add a recovery mechanism
 	err = d.ContinuousScan() // blocking function if it returns, it returns an error and Run is closed as well
 	return err
@@ -87,6 +117,11 @@ func (d *Daemon) LoadDataFromDB() error {
 	d.Mnemonic = keys.Mnemonic
 	// load keys in any case other data will be read in next step if available
 	wallet.LoadKeys(keys.ScanSecretKey, keys.SpendSecretKey)
+	err = wallet.LoadAccountKeys(&keys)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
 	err = wallet.CheckAndInitialiseFields()
 	if err != nil {
 		logging.ErrorLogger.Println(err)
@@ -103,15 +138,22 @@ func (d *Daemon) LoadDataFromDB() error {
 
 	d.Wallet = &wallet
 
+	err = d.loadSchedules()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
 	return nil
 }
 
 func (d *Daemon) Shutdown() error {
 	// todo save all data to a files
 	logging.InfoLogger.Println("Process shutting down")
+	d.cancel()
 
-	if d.ClientElectrum != nil {
-		d.ClientElectrum.Shutdown()
+	if d.Electrum != nil {
+		d.Electrum.Shutdown()
 	}
 	if d.Status == pb.Status_STATUS_NO_WALLET {
 		// we don't store anything if the wallet was not initialised yet
@@ -133,7 +175,7 @@ func (d *Daemon) Shutdown() error {
 func (d *Daemon) CreateNewKeys(seedPassphrase string) error {
 
 	var chainTip uint64
-	chainTip, err := d.ClientBlindBit.GetChainTip()
+	chainTip, err := d.Indexer.GetChainTip(d.ctx)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return err
@@ -147,6 +189,11 @@ func (d *Daemon) CreateNewKeys(seedPassphrase string) error {
 		return err
 	}
 	d.Wallet.LoadKeys(newKeys.ScanSecretKey, newKeys.SpendSecretKey)
+	err = d.Wallet.LoadAccountKeys(newKeys)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
 	if newKeys.Mnemonic == "" {
 		return errors.New("mnemonic is empty")
 	}
@@ -185,6 +232,11 @@ func (d *Daemon) RecoverFromSeed(mnemonic, seedPassphrase string, birthHeight ui
 		return err
 	}
 	d.Wallet.LoadKeys(newKeys.ScanSecretKey, newKeys.SpendSecretKey)
+	err = d.Wallet.LoadAccountKeys(newKeys)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
 	if newKeys.Mnemonic == "" {
 		return errors.New("mnemonic is empty")
 	}
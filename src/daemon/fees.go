@@ -0,0 +1,121 @@
+package daemon
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/networking"
+)
+
+const (
+	FeeSourceElectrum = "electrum"
+	FeeSourceIndexer  = "indexer"
+)
+
+type FeeEstimate struct {
+	ConfTarget       uint32
+	FeeRateMilliSats uint64 // millisats/vByte
+	Source           string
+	Timestamp        time.Time
+}
+
+// EstimateFee
+// returns a fee rate which should get a transaction confirmed within confTarget blocks.
+// Estimates are cached for src.FeeEstimateCacheDuration. Electrum is asked first, then the indexer (if enabled).
+// If no source can give an estimate, an expired cached estimate is returned as a last resort.
+func (d *Daemon) EstimateFee(confTarget uint32) (*FeeEstimate, error) {
+	if confTarget < 1 {
+		return nil, src.ErrInvalidConfTarget
+	}
+
+	d.feeEstimatesMu.Lock()
+	cached, ok := d.feeEstimates[confTarget]
+	d.feeEstimatesMu.Unlock()
+	if ok && time.Since(cached.Timestamp) < src.FeeEstimateCacheDuration {
+		return cached, nil
+	}
+
+	// the sources are asked without holding the lock, they can take a while to answer
+	estimate, err := d.fetchFeeEstimate(confTarget)
+	if err != nil {
+		if ok {
+			logging.WarningLogger.Printf("using expired fee estimate from %s for target %d: %s\n", cached.Timestamp, confTarget, err)
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	d.feeEstimatesMu.Lock()
+	if d.feeEstimates == nil {
+		d.feeEstimates = make(map[uint32]*FeeEstimate)
+	}
+	d.feeEstimates[confTarget] = estimate
+	d.feeEstimatesMu.Unlock()
+	return estimate, nil
+}
+
+// fetchFeeEstimate goes through all available fee sources until one returns a usable estimate
+func (d *Daemon) fetchFeeEstimate(confTarget uint32) (*FeeEstimate, error) {
+	if src.UseElectrum && d.Electrum != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		// electrum returns BTC/kvB and -1 if the server can't give an estimate
+		var feeRateBtcPerKvB float32
+		client, err := d.Electrum.Client()
+		if err == nil {
+			feeRateBtcPerKvB, err = client.GetFee(ctx, confTarget)
+		}
+		if err != nil {
+			logging.WarningLogger.Println("electrum fee estimate failed:", err)
+		} else if feeRateBtcPerKvB > 0 {
+			return &FeeEstimate{
+				ConfTarget:       confTarget,
+				FeeRateMilliSats: uint64(math.Ceil(float64(feeRateBtcPerKvB) * 1e8)),
+				Source:           FeeSourceElectrum,
+				Timestamp:        time.Now(),
+			}, nil
+		}
+	}
+
+	if estimator, ok := d.Indexer.(networking.FeeEstimator); ok && src.UseIndexerFeeEstimates {
+		estimates, err := estimator.GetFeeEstimates(d.ctx)
+		if err != nil {
+			logging.WarningLogger.Println("indexer fee estimate failed:", err)
+		} else if feeRate, ok := pickFeeRateForTarget(estimates, confTarget); ok {
+			return &FeeEstimate{
+				ConfTarget:       confTarget,
+				FeeRateMilliSats: uint64(math.Ceil(feeRate * 1000)),
+				Source:           FeeSourceIndexer,
+				Timestamp:        time.Now(),
+			}, nil
+		}
+	}
+
+	return nil, src.ErrNoFeeEstimateAvailable
+}
+
+// pickFeeRateForTarget
+// indexers only serve a fixed set of targets. If the exact target is not served
+// we take the closest lower target, which is the more conservative (higher) fee rate.
+func pickFeeRateForTarget(estimates map[uint32]float64, confTarget uint32) (float64, bool) {
+	if feeRate, ok := estimates[confTarget]; ok && feeRate > 0 {
+		return feeRate, true
+	}
+
+	var bestTarget uint32
+	var bestFeeRate float64
+	for target, feeRate := range estimates {
+		if target > confTarget || feeRate <= 0 {
+			continue
+		}
+		if target > bestTarget {
+			bestTarget = target
+			bestFeeRate = feeRate
+		}
+	}
+
+	return bestFeeRate, bestTarget != 0
+}
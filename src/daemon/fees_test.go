@@ -0,0 +1,138 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/networking"
+)
+
+func init() {
+	logging.LoadLoggersMock()
+}
+
+// feeIndexer serves fee estimates, the other indexer methods are not used
+type feeIndexer struct {
+	networking.Indexer
+	estimates map[uint32]float64
+	err       error
+	calls     int
+}
+
+func (f *feeIndexer) GetFeeEstimates(context.Context) (map[uint32]float64, error) {
+	f.calls++
+	return f.estimates, f.err
+}
+
+func TestPickFeeRateForTarget(t *testing.T) {
+	estimates := map[uint32]float64{1: 20, 3: 12, 6: 8, 144: 1, 200: 0}
+
+	testCases := []struct {
+		name       string
+		estimates  map[uint32]float64
+		confTarget uint32
+		feeRate    float64
+		ok         bool
+	}{
+		{name: "exact target", estimates: estimates, confTarget: 3, feeRate: 12, ok: true},
+		{name: "closest lower target", estimates: estimates, confTarget: 5, feeRate: 12, ok: true},
+		{name: "above all targets", estimates: estimates, confTarget: 1000, feeRate: 1, ok: true},
+		{name: "zero rates are skipped", estimates: estimates, confTarget: 200, feeRate: 1, ok: true},
+		{name: "below all targets", estimates: map[uint32]float64{2: 15}, confTarget: 1},
+		{name: "no estimates", estimates: nil, confTarget: 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			feeRate, ok := pickFeeRateForTarget(tc.estimates, tc.confTarget)
+			if ok != tc.ok || feeRate != tc.feeRate {
+				t.Fatalf("got %f %t, expected %f %t", feeRate, ok, tc.feeRate, tc.ok)
+			}
+		})
+	}
+}
+
+func TestEstimateFeeCache(t *testing.T) {
+	useIndexerFeeEstimates := src.UseIndexerFeeEstimates
+	src.UseIndexerFeeEstimates = true
+	t.Cleanup(func() { src.UseIndexerFeeEstimates = useIndexerFeeEstimates })
+
+	fresh := &FeeEstimate{ConfTarget: 6, FeeRateMilliSats: 5000, Source: FeeSourceIndexer, Timestamp: time.Now()}
+	expired := &FeeEstimate{ConfTarget: 6, FeeRateMilliSats: 4000, Source: FeeSourceIndexer, Timestamp: time.Now().Add(-time.Hour)}
+	unavailable := errors.New("unavailable")
+
+	testCases := []struct {
+		name     string
+		cached   *FeeEstimate
+		indexer  *feeIndexer
+		feeRate  uint64
+		calls    int
+		err      error
+		replaced bool // the cache holds the new estimate
+	}{
+		{
+			name:    "fresh estimate from cache",
+			cached:  fresh,
+			indexer: &feeIndexer{estimates: map[uint32]float64{6: 9}},
+			feeRate: 5000,
+		},
+		{
+			name:     "expired estimate is replaced",
+			cached:   expired,
+			indexer:  &feeIndexer{estimates: map[uint32]float64{6: 9}},
+			feeRate:  9000,
+			calls:    1,
+			replaced: true,
+		},
+		{
+			name:    "expired estimate if the source fails",
+			cached:  expired,
+			indexer: &feeIndexer{err: unavailable},
+			feeRate: 4000,
+			calls:   1,
+		},
+		{
+			name:    "expired estimate if the source has no estimate",
+			cached:  expired,
+			indexer: &feeIndexer{estimates: map[uint32]float64{12: 3}},
+			feeRate: 4000,
+			calls:   1,
+		},
+		{
+			name:    "no estimate at all",
+			indexer: &feeIndexer{err: unavailable},
+			calls:   1,
+			err:     src.ErrNoFeeEstimateAvailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &Daemon{Indexer: tc.indexer, ctx: context.Background(), feeEstimates: make(map[uint32]*FeeEstimate)}
+			if tc.cached != nil {
+				d.feeEstimates[6] = tc.cached
+			}
+
+			estimate, err := d.EstimateFee(6)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("got error %v, expected %v", err, tc.err)
+			}
+			if tc.indexer.calls != tc.calls {
+				t.Fatalf("source asked %d times, expected %d", tc.indexer.calls, tc.calls)
+			}
+			if tc.err != nil {
+				return
+			}
+			if estimate.FeeRateMilliSats != tc.feeRate {
+				t.Fatalf("fee rate %d, expected %d", estimate.FeeRateMilliSats, tc.feeRate)
+			}
+			if replaced := d.feeEstimates[6] != tc.cached; replaced != tc.replaced {
+				t.Fatalf("cache replaced: %t, expected %t", replaced, tc.replaced)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+	"github.com/setavenger/go-bip352"
+)
+
+// ImportUTXO
+// adds a confirmed output which was received on a regular (BIP 84 or BIP 86) address of this wallet.
+// The output is looked up via electrum and checked to be unspent.
+// Once imported the UTXO can be spent like any SP UTXO.
+func (d *Daemon) ImportUTXO(txid string, vout uint32) (*src.OwnedUTXO, error) {
+	if !d.Wallet.HasAccountKeys() {
+		return nil, src.ErrNoAccountKeys
+	}
+
+	tx, err := d.fetchTransaction(txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	if int(vout) >= len(tx.TxOut) {
+		return nil, fmt.Errorf("transaction %s has no output %d", txid, vout)
+	}
+	txOut := tx.TxOut[vout]
+
+	scriptType, path, err := d.Wallet.FindDerivationPath(txOut.PkScript, src.AccountGapLimit)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	client, err := d.Electrum.Client()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	unspent, err := client.ListUnspent(ctx, utils.ConvertPkScriptToScriptHash(txOut.PkScript))
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	var height uint32
+	for _, utxo := range unspent {
+		if utxo.Hash == txid && utxo.Position == vout {
+			height = utxo.Height
+			break
+		}
+	}
+	if height == 0 {
+		return nil, fmt.Errorf("output %s:%d is unconfirmed or already spent", txid, vout)
+	}
+
+	timestamp, err := d.fetchBlockTimestamp(height)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	txHash := tx.TxHash()
+	utxo := &src.OwnedUTXO{
+		Txid:           bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(txHash[:])),
+		Vout:           vout,
+		Amount:         uint64(txOut.Value),
+		Timestamp:      timestamp,
+		State:          src.StateUnspent,
+		ScriptType:     scriptType,
+		PkScript:       txOut.PkScript,
+		DerivationPath: path,
+	}
+	if scriptType == src.ScriptTypeP2TR {
+		utxo.PubKey = bip352.ConvertToFixedLength32(txOut.PkScript[2:])
+	}
+
+	err = d.Wallet.AddUTXOs(src.UtxoCollection{utxo})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	logging.InfoLogger.Printf("Imported %s:%d (%d sats)\n", txid, vout, utxo.Amount)
+
+	return utxo, nil
+}
+
+// fetchBlockTimestamp returns the timestamp of the block at height via electrum
+func (d *Daemon) fetchBlockTimestamp(height uint32) (uint64, error) {
+	client, err := d.Electrum.Client()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	headerResult, err := client.GetBlockHeader(ctx, height)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return 0, err
+	}
+
+	headerBytes, err := hex.DecodeString(headerResult.Header)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return 0, err
+	}
+
+	var header wire.BlockHeader
+	err = header.Deserialize(bytes.NewReader(headerBytes))
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return 0, err
+	}
+
+	return uint64(header.Timestamp.Unix()), nil
+}
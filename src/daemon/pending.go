@@ -0,0 +1,67 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// ListPendingTransactions returns all outgoing transactions which are waiting for confirmation or were dropped
+func (d *Daemon) ListPendingTransactions() []*src.OutgoingTransaction {
+	return d.Wallet.PendingTransactions()
+}
+
+// AbandonTransaction
+// gives up on an unconfirmed outgoing transaction and returns its inputs to unspent.
+// Nothing stops the transaction from still confirming if it is in a mempool somewhere.
+func (d *Daemon) AbandonTransaction(txid string) error {
+	err := d.Wallet.AbandonTransaction(txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	logging.InfoLogger.Printf("Abandoned transaction %s\n", txid)
+
+	return d.writeWallet()
+}
+
+// rebroadcastPendingTransactions
+// updates the states of the pending transactions and broadcasts those again
+// which were last broadcast more than src.RebroadcastInterval ago.
+// Failed rebroadcasts are only logged, the transaction is dropped once src.DropTimeout is reached.
+func (d *Daemon) rebroadcastPendingTransactions() error {
+	if d.Wallet == nil || len(d.Wallet.PendingTransactions()) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	dropped, err := d.Wallet.UpdatePendingTransactions(now, src.DropTimeout)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	for _, tx := range dropped {
+		logging.WarningLogger.Printf("transaction %s is unconfirmed since %s and considered dropped, bump or abandon it\n",
+			tx.Txid, time.Unix(int64(tx.FirstBroadcast), 0).Format(time.RFC3339))
+	}
+
+	for _, tx := range d.Wallet.PendingTransactions() {
+		if tx.State != src.OutgoingTxPending || now.Sub(time.Unix(int64(tx.LastBroadcast), 0)) < src.RebroadcastInterval {
+			continue
+		}
+
+		// aborted on Shutdown
+		ctx, cancel := context.WithTimeout(d.ctx, 60*time.Second)
+		_, err = d.Broadcasters.Broadcast(ctx, tx.RawTx)
+		cancel()
+		if err != nil {
+			logging.WarningLogger.Printf("rebroadcast of %s failed: %s\n", tx.Txid, err)
+		}
+		// also on failure, otherwise a failing backend would be asked every minute
+		tx.LastBroadcast = uint64(now.Unix())
+	}
+
+	return d.writeWallet()
+}
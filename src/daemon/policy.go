@@ -0,0 +1,37 @@
+package daemon
+
+import (
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// checkSpendingPolicy
+// checks the transaction against src.SpendingPolicy and logs the decision.
+// recipients are the recipients as given by the user (without change).
+// replaces is the txid of the transaction which is replaced via RBF, its amount is not counted towards the rolling limits.
+func (d *Daemon) checkSpendingPolicy(recipients []*src.Recipient, unsignedTx *unsignedTransaction, feeTarget src.FeeTarget, replaces string) error {
+	if src.SpendingPolicy == nil {
+		return nil
+	}
+
+	// a CPFP child has to pay a high rate for itself, only the package rate is relevant
+	feeRateMilliSats := feeTarget.FeeRateMilliSats
+	if !feeTarget.IsPackage() && unsignedTx.vByteEstimate > 0 {
+		feeRateMilliSats = uint64(float64(unsignedTx.fee) * 1000 / unsignedTx.vByteEstimate)
+	}
+
+	now := time.Now()
+	spentDay := d.Wallet.SpentSince(now.Add(-24*time.Hour), replaces)
+	spentWeek := d.Wallet.SpentSince(now.Add(-7*24*time.Hour), replaces)
+
+	err := src.SpendingPolicy.Check(recipients, feeRateMilliSats, spentDay, spentWeek)
+	if err != nil {
+		logging.WarningLogger.Printf("policy rejected transaction to %d recipients: %v\n", len(recipients), err)
+		return err
+	}
+
+	logging.InfoLogger.Printf("policy approved transaction to %d recipients (fee rate: %d msat/vByte, spent 24h: %d, spent 7d: %d)\n", len(recipients), feeRateMilliSats, spentDay, spentWeek)
+	return nil
+}
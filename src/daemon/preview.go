@@ -0,0 +1,88 @@
+package daemon
+
+import (
+	"bytes"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/coinselector"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+type TransactionPreview struct {
+	Inputs           src.UtxoCollection
+	Outputs          []*PreviewOutput // in the order of the final transaction
+	VSizeEstimate    float64          // estimated by the coin selector
+	Fee              uint64
+	FeeRateMilliSats uint64             // effective fee rate based on VSizeEstimate
+	MarkedSpent      src.UtxoCollection // UTXOs which would become spent_unconfirmed
+}
+
+type PreviewOutput struct {
+	Address  string // empty for the change output, the change label must not be shown to users
+	PkScript []byte
+	Amount   uint64
+	IsChange bool
+}
+
+// PreviewTransaction
+// runs the same coin selection and output derivation as SendToRecipients but does not sign anything.
+// The wallet is not modified.
+func (d *Daemon) PreviewTransaction(recipients []*src.Recipient, feeTarget src.FeeTarget, markSpent, useSpentUnconfirmed bool) (*TransactionPreview, error) {
+	err := src.ResolvePaymentURIs(recipients, src.ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	err = src.ValidateRecipients(recipients, src.ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	// work on a copy, building the transaction adds PkScripts to the recipients
+	selector := coinselector.NewFeeRateCoinSelector(d.Wallet.GetFreeUTXOs(useSpentUnconfirmed), uint64(src.MinChangeAmount), src.CopyRecipientsForRebuild(recipients))
+
+	unsignedTx, err := d.buildUnsignedTransaction(selector, feeTarget)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	// the preview is rejected just like the real transaction would be
+	err = d.checkSpendingPolicy(recipients, unsignedTx, feeTarget, "")
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	preview := &TransactionPreview{
+		Inputs:        unsignedTx.selectedUTXOs,
+		VSizeEstimate: unsignedTx.vByteEstimate,
+		Fee:           unsignedTx.fee,
+	}
+	if unsignedTx.vByteEstimate > 0 {
+		preview.FeeRateMilliSats = uint64(float64(unsignedTx.fee) * 1000 / unsignedTx.vByteEstimate)
+	}
+	if markSpent {
+		preview.MarkedSpent = unsignedTx.selectedUTXOs
+	}
+
+	for _, txOut := range unsignedTx.packet.UnsignedTx.TxOut {
+		output := &PreviewOutput{
+			PkScript: txOut.PkScript,
+			Amount:   uint64(txOut.Value),
+		}
+		for _, recipient := range unsignedTx.recipients {
+			if recipient.Amount == txOut.Value && bytes.Equal(recipient.PkScript, txOut.PkScript) {
+				output.IsChange = recipient == unsignedTx.change
+				if !output.IsChange {
+					output.Address = recipient.Address
+				}
+				break
+			}
+		}
+		preview.Outputs = append(preview.Outputs, output)
+	}
+
+	return preview, nil
+}
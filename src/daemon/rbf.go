@@ -0,0 +1,105 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/coinselector"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+)
+
+// BumpFee
+// creates a replacement (BIP 125) for an outgoing transaction which pays feeRateMilliSats.
+// The replacement spends all inputs of the original transaction and pays the same recipients.
+// The change is reduced first, additional inputs are only added if the change can't cover the higher fee.
+// SP outputs are recomputed as they depend on the set of inputs.
+// The wallet state is only changed once the replacement is broadcast, see applyBroadcastTransaction.
+func (d *Daemon) BumpFee(txid string, feeRateMilliSats uint64) (*src.OutgoingTransaction, error) {
+	original := d.Wallet.GetOutgoingTransaction(txid)
+	if original == nil {
+		return nil, src.ErrTransactionNotFound
+	}
+	if original.ReplacedBy != "" {
+		return nil, fmt.Errorf("transaction %s was already replaced by %s", txid, original.ReplacedBy)
+	}
+	if original.External {
+		return nil, fmt.Errorf("transaction %s was signed elsewhere and can't be rebuilt", txid)
+	}
+	if original.State == src.OutgoingTxAbandoned {
+		return nil, fmt.Errorf("transaction %s was abandoned", txid)
+	}
+	if feeRateMilliSats <= original.FeeRateMilliSats {
+		return nil, fmt.Errorf("%w: new %d msat/vByte <= old %d msat/vByte", src.ErrFeeRateTooLowForReplacement, feeRateMilliSats, original.FeeRateMilliSats)
+	}
+
+	msgTx, err := original.MsgTx()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	var requiredUTXOs src.UtxoCollection
+	requiredOutpoints := make(map[[36]byte]struct{}, len(msgTx.TxIn))
+	for _, txIn := range msgTx.TxIn {
+		var outpoint [36]byte
+		outpoint, err = utils.SerialiseWireOutpoint(txIn.PreviousOutPoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		var utxo *src.OwnedUTXO
+		utxo, err = d.Wallet.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if utxo == nil {
+			return nil, fmt.Errorf("input %s is not owned by this wallet", txIn.PreviousOutPoint)
+		}
+		if utxo.State == src.StateSpent {
+			// the original transaction (or a conflicting one) was already confirmed
+			return nil, fmt.Errorf("input %s is already spent, transaction can't be replaced", txIn.PreviousOutPoint)
+		}
+		requiredUTXOs = append(requiredUTXOs, utxo)
+		requiredOutpoints[outpoint] = struct{}{}
+	}
+
+	// the required utxos might still be unspent if the original transaction was created without marking them
+	var additionalUTXOs src.UtxoCollection
+	for _, utxo := range d.Wallet.GetFreeUTXOs(false) {
+		var outpoint [36]byte
+		outpoint, err = utxo.SerialiseToOutpoint()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if _, ok := requiredOutpoints[outpoint]; ok {
+			continue
+		}
+		additionalUTXOs = append(additionalUTXOs, utxo)
+	}
+
+	recipients := src.CopyRecipientsForRebuild(original.Recipients)
+	selector := coinselector.NewFeeRateCoinSelector(additionalUTXOs, uint64(src.MinChangeAmount), recipients)
+	selector.RequiredUTXOs = requiredUTXOs
+
+	replacement, _, err := d.createTransaction(selector, src.FeeTarget{FeeRateMilliSats: feeRateMilliSats}, original.Txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	// BIP 125 rule 4: the replacement has to pay for its own bandwidth at the incremental relay fee rate of 1 sat/vByte
+	if replacement.Fee < original.Fee+uint64(replacement.VSize) {
+		err = fmt.Errorf("%w: fee %d has to be at least %d", src.ErrFeeRateTooLowForReplacement, replacement.Fee, original.Fee+uint64(replacement.VSize))
+		return nil, err
+	}
+
+	replacement.Replaces = original.Txid
+	d.addCreatedTransaction(replacement, false, nil)
+
+	logging.InfoLogger.Printf("Created replacement %s for %s (fee: %d -> %d)\n", replacement.Txid, original.Txid, original.Fee, replacement.Fee)
+
+	return replacement, nil
+}
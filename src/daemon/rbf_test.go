@@ -0,0 +1,173 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/networking"
+	"github.com/setavenger/go-bip352"
+)
+
+// testBroadcaster accepts every transaction unless err is set
+type testBroadcaster struct {
+	err error
+}
+
+func (b *testBroadcaster) Name() string { return "test" }
+
+func (b *testBroadcaster) Broadcast(_ context.Context, rawTx []byte) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(rawTx))
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+// newTestDaemon returns an unlocked daemon on regtest with the test keys and no UTXOs
+func newTestDaemon(t *testing.T) (*Daemon, *testBroadcaster) {
+	chainParams := src.ChainParams
+	src.ChainParams = &chaincfg.RegressionNetParams
+	t.Cleanup(func() { src.ChainParams = chainParams })
+
+	scanBytes, _ := hex.DecodeString("78e7fd7d2b7a2c1456709d147021a122d2dccaafeada040cc1002083e2833b09")
+	spendBytes, _ := hex.DecodeString("c88567742d5019d7ccc81f6e82cef8ef01997a6a3761cc9166036b580549539b")
+
+	wallet := src.NewWallet(0)
+	wallet.LoadKeys(bip352.ConvertToFixedLength32(scanBytes), bip352.ConvertToFixedLength32(spendBytes))
+	err := wallet.CheckAndInitialiseFields()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := NewDaemon(wallet, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	broadcaster := &testBroadcaster{}
+	d.Broadcasters = networking.Broadcasters{broadcaster}
+	return d, broadcaster
+}
+
+// addTestUTXO adds a confirmed SP UTXO which the wallet can sign for, seed makes it unique
+func addTestUTXO(t *testing.T, d *Daemon, seed string, amount uint64) *src.OwnedUTXO {
+	tweak := sha256.Sum256([]byte("tweak" + seed))
+	secretKey := bip352.AddPrivateKeys(tweak, d.Wallet.SecretKeySpend())
+	_, pubKey := btcec.PrivKeyFromBytes(secretKey[:])
+
+	utxo := &src.OwnedUTXO{
+		Txid:         sha256.Sum256([]byte("txid" + seed)),
+		Vout:         0,
+		Amount:       amount,
+		PrivKeyTweak: tweak,
+		PubKey:       bip352.ConvertToFixedLength32(schnorr.SerializePubKey(pubKey)),
+		State:        src.StateUnspent,
+		ScriptType:   src.ScriptTypeSilentPayment,
+	}
+	err := d.Wallet.AddUTXOs(src.UtxoCollection{utxo})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utxo
+}
+
+// testAddress is a regtest taproot address outside the wallet
+func testAddress(t *testing.T) string {
+	key := sha256.Sum256([]byte("recipient"))
+	_, pubKey := btcec.PrivKeyFromBytes(key[:])
+	address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(pubKey), &chaincfg.RegressionNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return address.EncodeAddress()
+}
+
+// sendTestTransaction sends 50k sats out of the wallet and broadcasts the transaction
+func sendTestTransaction(t *testing.T, d *Daemon) *src.OutgoingTransaction {
+	rawTx, err := d.SendToRecipients([]*src.Recipient{{Address: testAddress(t), Amount: 50_000}}, src.FeeTarget{FeeRateMilliSats: 2_000}, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txid, err := d.BroadcastRawTx(rawTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d.Wallet.GetOutgoingTransaction(txid)
+}
+
+func TestBumpFeeBroadcastFails(t *testing.T) {
+	d, broadcaster := newTestDaemon(t)
+	input := addTestUTXO(t, d, "a", 100_000)
+	original := sendTestTransaction(t, d)
+	if original == nil || original.State != src.OutgoingTxPending {
+		t.Fatalf("original transaction not pending: %+v", original)
+	}
+
+	replacement, err := d.BumpFee(original.Txid, 5_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if original.ReplacedBy != "" {
+		t.Fatalf("original marked as replaced before the broadcast")
+	}
+
+	broadcaster.err = errors.New("rejected")
+	_, err = d.BroadcastRawTx(replacement.RawTx)
+	if err == nil {
+		t.Fatal("broadcast did not fail")
+	}
+
+	if original.ReplacedBy != "" || !original.IsUnconfirmed() {
+		t.Errorf("original replaced by a transaction which was never broadcast: %q", original.ReplacedBy)
+	}
+	if d.Wallet.GetOutgoingTransaction(replacement.Txid) != nil {
+		t.Errorf("replacement added to the wallet without being broadcast")
+	}
+	if input.State != src.StateUnconfirmedSpent {
+		t.Errorf("input of the original changed: %d", input.State)
+	}
+
+	// the wallet is not stuck, the original can still be abandoned or bumped again
+	err = d.Wallet.AbandonTransaction(original.Txid)
+	if err != nil {
+		t.Errorf("original can't be abandoned: %v", err)
+	}
+	if input.State != src.StateUnspent {
+		t.Errorf("input not returned after abandoning: %d", input.State)
+	}
+}
+
+func TestBumpFeeBroadcast(t *testing.T) {
+	d, _ := newTestDaemon(t)
+	addTestUTXO(t, d, "a", 100_000)
+	original := sendTestTransaction(t, d)
+
+	replacement, err := d.BumpFee(original.Txid, 5_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = d.BroadcastRawTx(replacement.RawTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if original.ReplacedBy != replacement.Txid || replacement.State != src.OutgoingTxPending {
+		t.Errorf("replacement not applied: replaced by %q, state %s", original.ReplacedBy, replacement.State)
+	}
+	if pending := d.Wallet.PendingTransactions(); len(pending) != 1 || pending[0] != replacement {
+		t.Errorf("expected only the replacement to be pending, got %d transactions", len(pending))
+	}
+}
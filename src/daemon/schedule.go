@@ -0,0 +1,201 @@
+package daemon
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/database"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+)
+
+// CreateSchedule
+// validates the recipients and stores the schedule. The first execution happens at StartHeight or StartTime.
+func (d *Daemon) CreateSchedule(schedule *src.Schedule) (*src.Schedule, error) {
+	err := src.ResolvePaymentURIs(schedule.Recipients, src.ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	err = src.ValidateRecipients(schedule.Recipients, src.ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	schedule, err = src.NewSchedule(schedule)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	d.schedulesMu.Lock()
+	defer d.schedulesMu.Unlock()
+
+	d.schedules.Add(schedule)
+	err = d.writeSchedules()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (d *Daemon) ListSchedules() []*src.Schedule {
+	d.schedulesMu.Lock()
+	defer d.schedulesMu.Unlock()
+
+	schedules := make([]*src.Schedule, len(d.schedules.Schedules))
+	copy(schedules, d.schedules.Schedules)
+	return schedules
+}
+
+func (d *Daemon) DeleteSchedule(id string) error {
+	d.schedulesMu.Lock()
+	defer d.schedulesMu.Unlock()
+
+	err := d.schedules.Delete(id)
+	if err != nil {
+		return err
+	}
+	return d.writeSchedules()
+}
+
+// executeDueSchedules
+// sends and broadcasts all schedules which are due at the current sync height.
+// Failed executions are recorded in the audit log of the schedule and retried with the next block.
+func (d *Daemon) executeDueSchedules() error {
+	if d.Locked {
+		return nil
+	}
+
+	d.schedulesMu.Lock()
+	defer d.schedulesMu.Unlock()
+
+	height := d.Wallet.LastScanHeight
+	now := time.Now()
+
+	due := d.schedules.Due(height, now)
+	if len(due) == 0 {
+		return nil
+	}
+
+	for _, schedule := range due {
+		execution, retry := d.executeSchedule(schedule)
+		execution.Height = height
+		schedule.Executions = append(schedule.Executions, execution)
+
+		if retry {
+			logging.WarningLogger.Printf("schedule %s failed: %s\n", schedule.Id, execution.Error)
+			schedule.RetryHeight = height + 1
+			continue
+		}
+
+		if execution.Error != "" {
+			logging.WarningLogger.Printf("schedule %s: broadcasting %s failed: %s\n", schedule.Id, execution.Txid, execution.Error)
+		} else {
+			logging.InfoLogger.Printf("schedule %s executed: %s\n", schedule.Id, execution.Txid)
+		}
+		schedule.Advance(height, now)
+	}
+
+	err := d.writeSchedules()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	// the wallet has new outgoing transactions and spent UTXOs
+	err = database.WriteToDB(src.PathDbWallet, d.Wallet, d.Password)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// executeSchedule
+// the fee caps are checked on a preview before anything is signed or marked as spent.
+// retry is false once a transaction was signed, it might have reached the network even if broadcasting failed.
+func (d *Daemon) executeSchedule(schedule *src.Schedule) (execution *src.ScheduleExecution, retry bool) {
+	execution = &src.ScheduleExecution{Timestamp: time.Now()}
+
+	feeTarget := src.FeeTarget{FeeRateMilliSats: schedule.FeeRateMilliSats}
+	if feeTarget.FeeRateMilliSats == 0 {
+		estimate, err := d.EstimateFee(schedule.ConfTarget)
+		if err != nil {
+			execution.Error = err.Error()
+			return execution, true
+		}
+		feeTarget.FeeRateMilliSats = estimate.FeeRateMilliSats
+	}
+	execution.FeeRateMilliSats = feeTarget.FeeRateMilliSats
+
+	if schedule.MaxFeeRateMilliSats > 0 && feeTarget.FeeRateMilliSats > schedule.MaxFeeRateMilliSats {
+		execution.Error = fmt.Sprintf("%v: fee rate %d msat/vByte is above %d msat/vByte", src.ErrScheduleFeeCapExceeded, feeTarget.FeeRateMilliSats, schedule.MaxFeeRateMilliSats)
+		return execution, true
+	}
+
+	// the stored recipients must never get PkScripts, the SP outputs depend on the inputs of every execution
+	preview, err := d.PreviewTransaction(src.CopyRecipientsForRebuild(schedule.Recipients), feeTarget, true, false)
+	if err != nil {
+		execution.Error = err.Error()
+		return execution, true
+	}
+	if schedule.MaxFee > 0 && preview.Fee > schedule.MaxFee {
+		execution.Error = fmt.Sprintf("%v: fee %d sats is above %d sats", src.ErrScheduleFeeCapExceeded, preview.Fee, schedule.MaxFee)
+		return execution, true
+	}
+
+	rawTx, err := d.SendToRecipients(src.CopyRecipientsForRebuild(schedule.Recipients), feeTarget, true, false)
+	if err != nil {
+		execution.Error = err.Error()
+		return execution, true
+	}
+	var tx wire.MsgTx
+	err = tx.Deserialize(bytes.NewReader(rawTx))
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		execution.Error = err.Error()
+		return execution, true
+	}
+	execution.Txid = tx.TxHash().String()
+	execution.Fee = preview.Fee
+
+	_, err = d.BroadcastRawTx(rawTx)
+	if err != nil {
+		execution.Error = err.Error()
+		return execution, false
+	}
+	// the wallet only knows the transaction once it was broadcast
+	if outgoingTx := d.Wallet.GetOutgoingTransaction(execution.Txid); outgoingTx != nil {
+		execution.Fee = outgoingTx.Fee
+		execution.FeeRateMilliSats = outgoingTx.FeeRateMilliSats
+	}
+
+	return execution, false
+}
+
+func (d *Daemon) loadSchedules() error {
+	d.schedulesMu.Lock()
+	defer d.schedulesMu.Unlock()
+
+	d.schedules = &src.ScheduleStore{}
+	if !utils.CheckIfFileExists(src.PathDbSchedules) {
+		return nil
+	}
+	return database.ReadFromDB(src.PathDbSchedules, d.schedules, d.Password)
+}
+
+// writeSchedules has to be called while holding schedulesMu
+func (d *Daemon) writeSchedules() error {
+	if d.Locked || d.Password == nil {
+		return nil
+	}
+	return database.WriteToDB(src.PathDbSchedules, d.schedules, d.Password)
+}
@@ -0,0 +1,34 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+func TestExecuteSchedule(t *testing.T) {
+	d, _ := newTestDaemon(t)
+	addTestUTXO(t, d, "a", 100_000)
+	addTestUTXO(t, d, "b", 100_000)
+	other := sendTestTransaction(t, d)
+
+	schedule := &src.Schedule{
+		Recipients:       []*src.Recipient{{Address: testAddress(t), Amount: 20_000}},
+		FeeRateMilliSats: 3_000,
+	}
+	execution, retry := d.executeSchedule(schedule)
+	if retry || execution.Error != "" {
+		t.Fatalf("execution failed: %s", execution.Error)
+	}
+
+	outgoingTx := d.Wallet.GetOutgoingTransaction(execution.Txid)
+	if outgoingTx == nil || outgoingTx == other {
+		t.Fatalf("execution does not point to the scheduled transaction: %s", execution.Txid)
+	}
+	if execution.Fee != outgoingTx.Fee || execution.FeeRateMilliSats != outgoingTx.FeeRateMilliSats {
+		t.Errorf("fee %d (%d msat/vByte), expected %d (%d msat/vByte)", execution.Fee, execution.FeeRateMilliSats, outgoingTx.Fee, outgoingTx.FeeRateMilliSats)
+	}
+	if outgoingTx.State != src.OutgoingTxPending {
+		t.Errorf("scheduled transaction not broadcast: %s", outgoingTx.State)
+	}
+}
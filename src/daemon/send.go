@@ -3,9 +3,8 @@ package daemon
 import (
 	"bytes"
 	"context"
-	"encoding/hex"
-	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -19,6 +18,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/setavenger/blindbitd/src"
 	"github.com/setavenger/blindbitd/src/coinselector"
+	"github.com/setavenger/blindbitd/src/database"
 	"github.com/setavenger/blindbitd/src/logging"
 	"github.com/setavenger/blindbitd/src/utils"
 	"github.com/setavenger/go-bip352"
@@ -26,16 +26,196 @@ import (
 
 const ExtraDataAmountKey = "amount"
 
+// SequenceRBF is set for all inputs to signal opt-in replace-by-fee (BIP 125)
+const SequenceRBF = wire.MaxTxInSequenceNum - 2
+
 // SendToRecipients
-// creates a signed transaction that sends to the specified recipients
+// creates a signed transaction that sends to the specified recipients, recipients given as BIP 21 URI are resolved first
 // todo should all these functions just be Daemon functions
+// feeTarget either sets a fee rate or an absolute fee for the transaction
 // use markSpent to set the used UTXOs to spent_unconfirmed
 // use useSpentUnconfirmed to also include spent_undconfirmed UTXOs in the coinSelection process
-func (d *Daemon) SendToRecipients(recipients []*src.Recipient, feeRate int64, markSpent, useSpentUnconfirmed bool) ([]byte, error) {
+func (d *Daemon) SendToRecipients(recipients []*src.Recipient, feeTarget src.FeeTarget, markSpent, useSpentUnconfirmed bool) ([]byte, error) {
+	err := src.ResolvePaymentURIs(recipients, src.ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	err = src.ValidateRecipients(recipients, src.ChainParams)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
 
 	selector := coinselector.NewFeeRateCoinSelector(d.Wallet.GetFreeUTXOs(useSpentUnconfirmed), uint64(src.MinChangeAmount), recipients)
 
-	selectedUTXOs, changeAmount, err := selector.CoinSelect(uint32(feeRate))
+	outgoingTx, vins, err := d.createTransaction(selector, feeTarget, "")
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	if markSpent {
+		// now that everything worked mark as spent if desired
+		err = d.markVinsSpent(vins)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	}
+
+	d.addCreatedTransaction(outgoingTx, markSpent, nil)
+
+	return outgoingTx.RawTx, err
+}
+
+// createdTransactionExpiry is how long a transaction built by the daemon is kept while waiting for its broadcast
+const createdTransactionExpiry = 24 * time.Hour
+
+// createdTransaction is a transaction built by the daemon which was not broadcast yet
+type createdTransaction struct {
+	tx          *src.OutgoingTransaction
+	markedSpent bool               // the inputs were marked as spent_unconfirmed when the transaction was created
+	newUTXOs    src.UtxoCollection // inputs which are added to the wallet on broadcast, e.g. the parent outputs of a CPFP child
+	created     time.Time
+}
+
+// addCreatedTransaction
+// keeps tx until it is broadcast, the wallet only tracks transactions which were actually broadcast.
+// Transactions which were not broadcast within createdTransactionExpiry are dropped and inputs marked for them are released.
+func (d *Daemon) addCreatedTransaction(tx *src.OutgoingTransaction, markedSpent bool, newUTXOs src.UtxoCollection) {
+	d.createdTxsMu.Lock()
+	defer d.createdTxsMu.Unlock()
+
+	now := time.Now()
+	for txid, createdTx := range d.createdTxs {
+		if now.Sub(createdTx.created) <= createdTransactionExpiry {
+			continue
+		}
+		delete(d.createdTxs, txid)
+		if !createdTx.markedSpent {
+			continue
+		}
+		msgTx, err := createdTx.tx.MsgTx()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			continue
+		}
+		released, err := d.Wallet.ReleaseTxInputs(msgTx)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			continue
+		}
+		logging.InfoLogger.Printf("Released %d inputs of %s which was never broadcast\n", len(released), txid)
+	}
+	d.createdTxs[tx.Txid] = &createdTransaction{tx: tx, markedSpent: markedSpent, newUTXOs: newUTXOs, created: now}
+}
+
+// takeCreatedTransaction returns and forgets the created transaction with txid, nil if there is none
+func (d *Daemon) takeCreatedTransaction(txid string) *createdTransaction {
+	d.createdTxsMu.Lock()
+	defer d.createdTxsMu.Unlock()
+
+	createdTx := d.createdTxs[txid]
+	delete(d.createdTxs, txid)
+	return createdTx
+}
+
+// unsignedTransaction holds everything of a transaction after coin selection and before signing
+type unsignedTransaction struct {
+	packet        *psbt.Packet
+	vins          []*bip352.Vin
+	selectedUTXOs src.UtxoCollection
+	recipients    []*src.Recipient // final recipients including change, all with PkScripts
+	change        *src.Recipient   // nil if the transaction has no change output
+	fee           uint64
+	vByteEstimate float64 // size estimated by the coin selector
+}
+
+// createTransaction
+// runs the coin selection of the given selector and builds the final signed transaction for the selector's recipients.
+// Change is sent to the wallet's change label. Nothing is marked as spent.
+// The transaction is checked against the spending policy before signing, replaces is the txid of the transaction replaced via RBF.
+func (d *Daemon) createTransaction(selector *coinselector.FeeRateCoinSelector, feeTarget src.FeeTarget, replaces string) (*src.OutgoingTransaction, []*bip352.Vin, error) {
+	// keep the recipients as given by the user, ParseRecipients modifies them
+	originalRecipients := src.CopyRecipientsForRebuild(selector.Recipients)
+
+	unsignedTx, err := d.buildUnsignedTransaction(selector, feeTarget)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, nil, err
+	}
+	packet := unsignedTx.packet
+
+	err = d.checkSpendingPolicy(originalRecipients, unsignedTx, feeTarget, replaces)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, nil, err
+	}
+
+	err = SignPsbt(packet, unsignedTx.vins)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, nil, err
+	}
+
+	err = psbt.MaybeFinalizeAll(packet)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		panic(err) // todo remove panic
+	}
+
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		panic(err) // todo remove panic
+	}
+
+	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(finalTx))
+	actualFee := int64(unsignedTx.fee)
+
+	err = checkActualFee(actualFee, vSize, feeTarget)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	err = finalTx.Serialize(&buf)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, nil, err
+	}
+
+	outgoingTx := &src.OutgoingTransaction{
+		Txid:             finalTx.TxHash().String(),
+		RawTx:            buf.Bytes(),
+		Recipients:       originalRecipients,
+		Fee:              uint64(actualFee),
+		FeeRateMilliSats: uint64(actualFee) * 1000 / uint64(vSize),
+		VSize:            vSize,
+		Timestamp:        uint64(time.Now().Unix()),
+	}
+
+	return outgoingTx, unsignedTx.vins, nil
+}
+
+// buildUnsignedTransaction
+// runs the coin selection, adds change and derives the SP outputs. The returned psbt is not signed.
+// NOTE: the recipients of the selector are modified (PkScripts are added)
+func (d *Daemon) buildUnsignedTransaction(selector *coinselector.FeeRateCoinSelector, feeTarget src.FeeTarget) (*unsignedTransaction, error) {
+	recipients := selector.Recipients
+
+	var selectedUTXOs src.UtxoCollection
+	var changeAmount uint64
+	var err error
+	if feeTarget.IsAbsolute() {
+		selectedUTXOs, changeAmount, err = selector.CoinSelectAbsoluteFee(feeTarget.AbsoluteFee)
+	} else if feeTarget.IsPackage() {
+		selectedUTXOs, changeAmount, err = selector.CoinSelectPackage(feeTarget.FeeRateMilliSats, feeTarget.AncestorVSize, feeTarget.AncestorFee)
+	} else {
+		selectedUTXOs, changeAmount, err = selector.CoinSelectMilliSats(feeTarget.FeeRateMilliSats)
+	}
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -44,10 +224,11 @@ func (d *Daemon) SendToRecipients(recipients []*src.Recipient, feeRate int64, ma
 	// vins is the final selection of coins, which can then be used to derive silentPayment Outputs
 	var vins = make([]*bip352.Vin, len(selectedUTXOs))
 	for i, utxo := range selectedUTXOs {
-		vin := src.ConvertOwnedUTXOIntoVin(utxo)
-		fullVinSecretKey := bip352.AddPrivateKeys(*vin.SecretKey, d.Wallet.SecretKeySpend())
-		vin.SecretKey = &fullVinSecretKey
-		vins[i] = &vin
+		vins[i], err = d.Wallet.ConvertUTXOIntoSigningVin(utxo)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
 	}
 
 	// now we need the difference between the inputs and outputs so that we can assign a value for change
@@ -83,79 +264,52 @@ func (d *Daemon) SendToRecipients(recipients []*src.Recipient, feeRate int64, ma
 		return nil, err
 	}
 
-	err = SignPsbt(packet, vins)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
-
-	err = psbt.MaybeFinalizeAll(packet)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		panic(err) // todo remove panic
-	}
-
-	finalTx, err := psbt.Extract(packet)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		panic(err) // todo remove panic
-	}
-
 	var sumAllOutputs int64
+	var change *src.Recipient
 	for _, recipient := range recipients {
 		sumAllOutputs += recipient.Amount
-	}
-	vSize := mempool.GetTxVirtualSize(btcutil.NewTx(finalTx))
-	actualFee := sumAllInputs - sumAllOutputs
-	actualFeeRate := float64(actualFee) / float64(vSize)
-
-	errorTerm := 0.25 // todo make variable
-	if actualFeeRate > float64(feeRate)+errorTerm {
-		err = fmt.Errorf("actual fee rate deviates to strong from desired fee rate: %f > %d", actualFeeRate, feeRate)
-		return nil, err
-	}
-
-	if actualFeeRate < float64(feeRate)-errorTerm {
-		err = fmt.Errorf("actual fee rate deviates to strong from desired fee rate: %f < %d", actualFeeRate, feeRate)
-		return nil, err
+		if changeAmount > 0 && change == nil && recipient.Address == d.Wallet.ChangeLabel.Address && recipient.Amount == int64(changeAmount) {
+			change = recipient
+		}
 	}
 
-	var buf bytes.Buffer
-	err = finalTx.Serialize(&buf)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
+	return &unsignedTransaction{
+		packet:        packet,
+		vins:          vins,
+		selectedUTXOs: selectedUTXOs,
+		recipients:    recipients,
+		change:        change,
+		fee:           uint64(sumAllInputs - sumAllOutputs),
+		vByteEstimate: selector.VByteEstimate,
+	}, nil
+}
 
-	if markSpent {
-		var found int
-		// now that everything worked mark as spent if desired
-		for _, vin := range vins {
-			vinOutpoint, err := utils.SerialiseVinToOutpoint(*vin)
+// markVinsSpent sets the state of the wallet's UTXOs used as vins to spent_unconfirmed
+func (d *Daemon) markVinsSpent(vins []*bip352.Vin) error {
+	var found int
+	for _, vin := range vins {
+		vinOutpoint, err := utils.SerialiseVinToOutpoint(*vin)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		for _, utxo := range d.Wallet.UTXOs {
+			utxoOutpoint, err := utxo.SerialiseToOutpoint()
 			if err != nil {
 				logging.ErrorLogger.Println(err)
-				return nil, err
+				return err
 			}
-			for _, utxo := range d.Wallet.UTXOs {
-				utxoOutpoint, err := utxo.SerialiseToOutpoint()
-				if err != nil {
-					logging.ErrorLogger.Println(err)
-					return nil, err
-				}
-				if bytes.Equal(vinOutpoint[:], utxoOutpoint[:]) {
-					utxo.State = src.StateUnconfirmedSpent
-					found++
-					logging.DebugLogger.Printf("Marked %x as spent\n", utxoOutpoint)
-				}
+			if bytes.Equal(vinOutpoint[:], utxoOutpoint[:]) {
+				utxo.State = src.StateUnconfirmedSpent
+				found++
+				logging.DebugLogger.Printf("Marked %x as spent\n", utxoOutpoint)
 			}
 		}
-		if found != len(vins) {
-			err = fmt.Errorf("we could not mark enough utxos as spent. marked %d, needed %d", found, len(vins))
-			return nil, err
-		}
 	}
-
-	return buf.Bytes(), err
+	if found != len(vins) {
+		return fmt.Errorf("we could not mark enough utxos as spent. marked %d, needed %d", found, len(vins))
+	}
+	return nil
 }
 
 // ParseRecipients
@@ -255,7 +409,9 @@ func CreateUnsignedPsbt(recipients []*src.Recipient, vins []*bip352.Vin) (*psbt.
 			return nil, err
 		}
 		prevOut := wire.NewOutPoint(hash, vin.Vout)
-		txInputs = append(txInputs, wire.NewTxIn(prevOut, nil, nil))
+		txIn := wire.NewTxIn(prevOut, nil, nil)
+		txIn.Sequence = SequenceRBF
+		txInputs = append(txInputs, txIn)
 	}
 
 	unsignedTx := &wire.MsgTx{
@@ -305,13 +461,24 @@ func SignPsbt(packet *psbt.Packet, vins []*bip352.Vin) error {
 	var pInputs []psbt.PInput
 
 	for iOuter, input := range packet.UnsignedTx.TxIn {
-		signatureHash, err := txscript.CalcTaprootSignatureHash(sigHashes, txscript.SigHashDefault, packet.UnsignedTx, iOuter, multiFetcher)
+		vin, err := matchVin(input, vins)
 		if err != nil {
 			logging.ErrorLogger.Println(err)
-			panic(err)
+			return err
 		}
 
-		pInput, err := matchAndSign(input, signatureHash, vins)
+		var pInput psbt.PInput
+		if txscript.IsPayToWitnessPubKeyHash(vin.ScriptPubKey) {
+			pInput, err = signP2WPKH(packet.UnsignedTx, iOuter, sigHashes, vin)
+		} else {
+			var signatureHash []byte
+			signatureHash, err = txscript.CalcTaprootSignatureHash(sigHashes, txscript.SigHashDefault, packet.UnsignedTx, iOuter, multiFetcher)
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				panic(err)
+			}
+			pInput, err = signTaprootKeyPath(signatureHash, vin)
+		}
 		if err != nil {
 			logging.ErrorLogger.Println(err)
 			panic(err)
@@ -327,41 +494,98 @@ func SignPsbt(packet *psbt.Packet, vins []*bip352.Vin) error {
 
 }
 
-func matchAndSign(input *wire.TxIn, signatureHash []byte, vins []*bip352.Vin) (psbt.PInput, error) {
-	var psbtInput psbt.PInput
-
+func matchVin(input *wire.TxIn, vins []*bip352.Vin) (*bip352.Vin, error) {
 	for _, vin := range vins {
 		if bytes.Equal(input.PreviousOutPoint.Hash[:], bip352.ReverseBytesCopy(vin.Txid[:])) &&
 			input.PreviousOutPoint.Index == vin.Vout {
-			privKey, pk := btcec.PrivKeyFromBytes(vin.SecretKey[:])
+			return vin, nil
+		}
+	}
+	return nil, src.ErrNoMatchingVinFoundForTxInput
+}
 
-			if pk.Y().Bit(0) == 1 {
-				newBytes := privKey.Key.Negate().Bytes()
-				privKey, _ = btcec.PrivKeyFromBytes(newBytes[:])
-			}
-			signature, err := schnorr.Sign(privKey, signatureHash)
-			if err != nil {
-				logging.ErrorLogger.Println(err)
-				panic(err)
-			}
+// signTaprootKeyPath
+// signs SP outputs and BIP 86 outputs, vin.SecretKey has to be the key of the output key
+func signTaprootKeyPath(signatureHash []byte, vin *bip352.Vin) (psbt.PInput, error) {
+	privKey, pk := btcec.PrivKeyFromBytes(vin.SecretKey[:])
 
-			var witnessBytes bytes.Buffer
-			err = psbt.WriteTxWitness(&witnessBytes, [][]byte{signature.Serialize()})
-			if err != nil {
-				logging.ErrorLogger.Println(err)
-				panic(err)
-			}
+	if pk.Y().Bit(0) == 1 {
+		newBytes := privKey.Key.Negate().Bytes()
+		privKey, _ = btcec.PrivKeyFromBytes(newBytes[:])
+	}
+	signature, err := schnorr.Sign(privKey, signatureHash)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return psbt.PInput{}, err
+	}
+
+	var witnessBytes bytes.Buffer
+	err = psbt.WriteTxWitness(&witnessBytes, [][]byte{signature.Serialize()})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return psbt.PInput{}, err
+	}
+
+	return psbt.PInput{
+		WitnessUtxo:        wire.NewTxOut(int64(vin.Amount), vin.ScriptPubKey),
+		SighashType:        txscript.SigHashDefault,
+		FinalScriptWitness: witnessBytes.Bytes(),
+	}, err
+}
 
-			return psbt.PInput{
-				WitnessUtxo:        wire.NewTxOut(int64(vin.Amount), vin.ScriptPubKey),
-				SighashType:        txscript.SigHashDefault,
-				FinalScriptWitness: witnessBytes.Bytes(),
-			}, err
+// signP2WPKH creates an ECDSA signature (BIP 143) for a P2WPKH input
+func signP2WPKH(tx *wire.MsgTx, idx int, sigHashes *txscript.TxSigHashes, vin *bip352.Vin) (psbt.PInput, error) {
+	privKey, _ := btcec.PrivKeyFromBytes(vin.SecretKey[:])
+
+	witness, err := txscript.WitnessSignature(tx, sigHashes, idx, int64(vin.Amount), vin.ScriptPubKey, txscript.SigHashAll, privKey, true)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return psbt.PInput{}, err
+	}
+
+	var witnessBytes bytes.Buffer
+	err = psbt.WriteTxWitness(&witnessBytes, witness)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return psbt.PInput{}, err
+	}
+
+	return psbt.PInput{
+		WitnessUtxo:        wire.NewTxOut(int64(vin.Amount), vin.ScriptPubKey),
+		SighashType:        txscript.SigHashAll,
+		FinalScriptWitness: witnessBytes.Bytes(),
+	}, err
+}
+
+// checkActualFee
+// makes sure that the fee of the final transaction matches the requested feeTarget.
+// An absolute fee has to be matched exactly, fee rates have to be within a small error term.
+// For package fee targets the fee rate of the transaction together with its ancestors is checked.
+func checkActualFee(actualFee, vSize int64, feeTarget src.FeeTarget) error {
+	if feeTarget.IsAbsolute() {
+		if actualFee != int64(feeTarget.AbsoluteFee) {
+			return fmt.Errorf("actual fee does not match desired absolute fee: %d != %d", actualFee, feeTarget.AbsoluteFee)
 		}
+		return nil
+	}
+
+	actualFeeRate := float64(actualFee) / float64(vSize)
+	if feeTarget.IsPackage() {
+		// the package has to reach the fee rate not the child alone
+		actualFeeRate = float64(actualFee+int64(feeTarget.AncestorFee)) / float64(vSize+int64(feeTarget.AncestorVSize))
+	}
+	feeRate := feeTarget.FeeRate()
+
+	errorTerm := 0.25 // todo make variable
+	if actualFeeRate > feeRate+errorTerm {
+		return fmt.Errorf("actual fee rate deviates to strong from desired fee rate: %f > %.3f", actualFeeRate, feeRate)
 	}
 
-	return psbtInput, src.ErrNoMatchingVinFoundForTxInput
+	if actualFeeRate < feeRate-errorTerm {
+		return fmt.Errorf("actual fee rate deviates to strong from desired fee rate: %f < %.3f", actualFeeRate, feeRate)
+	}
 
+	return nil
 }
 
 /*  util functions */
@@ -377,15 +601,140 @@ func ConvertSPRecipient(recipient *bip352.Recipient) *src.Recipient {
 }
 
 // BroadcastTx
-// broadcasts a transaction and returns the txid
+// broadcasts a transaction through the configured backends and returns the txid
 func (d *Daemon) BroadcastTx(rawTx []byte) (string, error) {
-	if !src.UseElectrum {
-		return "", errors.New("currently can't broadcast without electrum; either activate electrum or publish on another channel")
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	txid, err := d.Broadcasters.Broadcast(ctx, rawTx)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return "", err
 	}
-	// todo parse tx and check against the outpoints which where spent and mark UTXOs locally.
-	txid, err := d.ClientElectrum.BroadcastTransaction(context.Background(), hex.EncodeToString(rawTx))
+
+	// transactions of the wallet are tracked until they confirm
+	if outgoingTx := d.Wallet.GetOutgoingTransaction(txid); outgoingTx != nil {
+		outgoingTx.MarkBroadcast(time.Now())
+	}
+	return txid, nil
+}
+
+// BroadcastRawTx
+// broadcasts a transaction which might have been signed outside the daemon and applies it to the wallet.
+// Our UTXOs spent by the transaction are marked as spent_unconfirmed, outputs paying to us (e.g. change) are added as unconfirmed.
+func (d *Daemon) BroadcastRawTx(rawTx []byte) (string, error) {
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(rawTx))
 	if err != nil {
+		logging.ErrorLogger.Println(err)
 		return "", err
 	}
+
+	txid, err := d.BroadcastTx(rawTx)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return "", err
+	}
+
+	// the transaction is out already, so failing to update the wallet is not returned as an error
+	err = d.applyBroadcastTransaction(&tx, rawTx)
+	if err != nil {
+		logging.ErrorLogger.Printf("could not apply broadcast transaction %s to the wallet: %s\n", txid, err)
+	}
+
 	return txid, nil
 }
+
+// applyBroadcastTransaction
+// marks the spent UTXOs and adds the outputs of tx which belong to the wallet.
+// Transactions created by the daemon are added to the wallet now,
+// transactions signed elsewhere which spend our UTXOs are tracked like our own until they confirm.
+func (d *Daemon) applyBroadcastTransaction(tx *wire.MsgTx, rawTx []byte) error {
+	txid := tx.TxHash().String()
+	createdTx := d.takeCreatedTransaction(txid)
+	if createdTx != nil {
+		// the inputs have to be known to the wallet in order to mark them as spent
+		err := d.Wallet.AddUTXOs(createdTx.newUTXOs)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+	}
+
+	spentUTXOs, err := d.Wallet.MarkTxInputsSpent(tx)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	for _, utxo := range spentUTXOs {
+		logging.DebugLogger.Printf("Marked %x:%d as spent\n", utxo.Txid, utxo.Vout)
+	}
+	// e.g. a fee bump, the replaced transaction can't confirm anymore
+	for _, replaced := range d.Wallet.MarkReplacedTransactions(tx) {
+		logging.InfoLogger.Printf("Replaced %s with %s\n", replaced.Txid, replaced.ReplacedBy)
+	}
+
+	if createdTx != nil && d.Wallet.GetOutgoingTransaction(txid) == nil {
+		createdTx.tx.MarkBroadcast(time.Now())
+		d.Wallet.AddOutgoingTransaction(createdTx.tx)
+	} else if len(spentUTXOs) > 0 && d.Wallet.GetOutgoingTransaction(txid) == nil {
+		outgoingTx := &src.OutgoingTransaction{
+			Txid:      txid,
+			RawTx:     rawTx,
+			VSize:     mempool.GetTxVirtualSize(btcutil.NewTx(tx)),
+			Timestamp: uint64(time.Now().Unix()),
+			External:  true,
+		}
+		outgoingTx.MarkBroadcast(time.Now())
+		d.Wallet.AddOutgoingTransaction(outgoingTx)
+	}
+
+	// the tweak needs all prevouts, ours are known locally, foreign ones can only be fetched via electrum
+	prevOuts := make(map[wire.OutPoint]*wire.TxOut, len(tx.TxIn))
+	for _, utxo := range spentUTXOs {
+		var hash *chainhash.Hash
+		hash, err = chainhash.NewHash(bip352.ReverseBytesCopy(utxo.Txid[:]))
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		prevOuts[*wire.NewOutPoint(hash, utxo.Vout)] = wire.NewTxOut(int64(utxo.Amount), utxo.ScriptPubKey())
+	}
+	if len(prevOuts) != len(tx.TxIn) {
+		if !src.UseElectrum || d.Electrum == nil {
+			logging.WarningLogger.Printf("not all inputs of %s belong to the wallet, outputs to the wallet are found once the block is scanned\n", tx.TxHash())
+			return d.writeWallet()
+		}
+		prevOuts, err = d.fetchPrevOuts(tx)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+	}
+
+	ownUTXOs, err := d.scanTransaction(tx, prevOuts)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	err = d.Wallet.AddUTXOs(ownUTXOs)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+
+	return d.writeWallet()
+}
+
+// writeWallet persists the wallet, nothing is written while the daemon is locked
+func (d *Daemon) writeWallet() error {
+	if d.Locked || d.Password == nil {
+		return nil
+	}
+	err := database.WriteToDB(src.PathDbWallet, d.Wallet, d.Password)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+func TestSendToRecipientsAddedOnBroadcast(t *testing.T) {
+	d, _ := newTestDaemon(t)
+	input := addTestUTXO(t, d, "a", 100_000)
+
+	rawTx, err := d.SendToRecipients([]*src.Recipient{{Address: testAddress(t), Amount: 50_000}}, src.FeeTarget{FeeRateMilliSats: 2_000}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Wallet.OutgoingTransactions) != 0 {
+		t.Fatalf("transaction added to the wallet before the broadcast")
+	}
+
+	txid, err := d.BroadcastRawTx(rawTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outgoingTx := d.Wallet.GetOutgoingTransaction(txid)
+	if outgoingTx == nil || outgoingTx.External || outgoingTx.State != src.OutgoingTxPending || len(outgoingTx.Recipients) == 0 {
+		t.Fatalf("broadcast transaction not tracked with its recipients: %+v", outgoingTx)
+	}
+	if input.State != src.StateUnconfirmedSpent {
+		t.Errorf("input not marked as spent: %d", input.State)
+	}
+}
+
+func TestCreatedTransactionExpiry(t *testing.T) {
+	d, _ := newTestDaemon(t)
+	input := addTestUTXO(t, d, "a", 100_000)
+
+	rawTx, err := d.SendToRecipients([]*src.Recipient{{Address: testAddress(t), Amount: 50_000}}, src.FeeTarget{FeeRateMilliSats: 2_000}, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input.State != src.StateUnconfirmedSpent {
+		t.Fatalf("input not marked as spent: %d", input.State)
+	}
+	for _, createdTx := range d.createdTxs {
+		createdTx.created = time.Now().Add(-createdTransactionExpiry - time.Minute)
+	}
+
+	// creating the next transaction drops the stale one and releases its input
+	addTestUTXO(t, d, "b", 100_000)
+	_, err = d.SendToRecipients([]*src.Recipient{{Address: testAddress(t), Amount: 40_000}}, src.FeeTarget{FeeRateMilliSats: 2_000}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input.State != src.StateUnspent {
+		t.Errorf("input of the stale transaction not released: %d", input.State)
+	}
+	if len(d.createdTxs) != 1 {
+		t.Errorf("stale transaction not dropped: %d created transactions", len(d.createdTxs))
+	}
+
+	// broadcast anyway it is only tracked as spending our UTXOs
+	txid, err := d.BroadcastRawTx(rawTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outgoingTx := d.Wallet.GetOutgoingTransaction(txid); outgoingTx == nil || !outgoingTx.External {
+		t.Errorf("dropped transaction still tracked as created by the daemon: %+v", outgoingTx)
+	}
+}
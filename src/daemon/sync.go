@@ -23,9 +23,9 @@ import (
 )
 
 // syncBlock there are several possibilities how this returns no error and still an empty slice for FoundOutputs
-func (d *Daemon) syncBlock(blockHeight uint64) ([]*src.OwnedUTXO, error) {
+func (d *Daemon) syncBlock(blockHeight, chainTip uint64) ([]*src.OwnedUTXO, error) {
 
-	tweaks, err := d.ClientBlindBit.GetTweaks(blockHeight, src.DustLimit)
+	tweaks, err := d.getTweaks(blockHeight, chainTip)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -98,7 +98,7 @@ func (d *Daemon) syncBlock(blockHeight uint64) ([]*src.OwnedUTXO, error) {
 		return nil, nil
 	}
 
-	filterData, err := d.ClientBlindBit.GetFilter(blockHeight, networking.NewUTXOFilterType)
+	filterData, err := d.Indexer.GetFilter(d.ctx, blockHeight, networking.NewUTXOFilterType)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -113,7 +113,7 @@ func (d *Daemon) syncBlock(blockHeight uint64) ([]*src.OwnedUTXO, error) {
 		return nil, nil
 	}
 
-	utxos, err := d.ClientBlindBit.GetUTXOs(blockHeight)
+	utxos, err := d.Indexer.GetUTXOs(d.ctx, blockHeight)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -170,10 +170,26 @@ func (d *Daemon) syncBlock(blockHeight uint64) ([]*src.OwnedUTXO, error) {
 	return ownedUTXOs, err
 }
 
+// getTweaks
+// fetches the cut-through tweaks or the full tweak index, see src.UseCutThroughTweaks.
+// In auto mode indexers which don't serve the tweak index fall back to the cut-through tweaks.
+func (d *Daemon) getTweaks(blockHeight, chainTip uint64) ([][33]byte, error) {
+	if src.UseCutThroughTweaks(blockHeight, chainTip) {
+		return d.Indexer.GetTweaks(d.ctx, blockHeight, src.DustLimit)
+	}
+
+	tweaks, err := d.Indexer.GetTweakIndex(d.ctx, blockHeight, src.DustLimit)
+	if errors.Is(err, src.ErrIndexerNotFound) && src.TweakSource == src.TweakSourceAuto {
+		logging.WarningLogger.Printf("no tweak index for block %d, using the cut-through tweaks\n", blockHeight)
+		return d.Indexer.GetTweaks(d.ctx, blockHeight, src.DustLimit)
+	}
+	return tweaks, err
+}
+
 func (d *Daemon) SyncToTip(chainTip uint64) error {
 	var err error
 	if chainTip == 0 {
-		chainTip, err = d.ClientBlindBit.GetChainTip()
+		chainTip, err = d.Indexer.GetChainTip(d.ctx)
 		if err != nil {
 			logging.ErrorLogger.Println(err)
 			return err
@@ -201,7 +217,7 @@ func (d *Daemon) SyncToTip(chainTip uint64) error {
 		// possible logging here to indicate to the user
 		logging.DebugLogger.Println("syncing:", i)
 		var ownedUTXOs []*src.OwnedUTXO
-		ownedUTXOs, err = d.syncBlock(i)
+		ownedUTXOs, err = d.syncBlock(i, chainTip)
 		if err != nil {
 			logging.ErrorLogger.Println(err)
 			return err
@@ -235,7 +251,7 @@ func (d *Daemon) SyncToTip(chainTip uint64) error {
 }
 
 func (d *Daemon) ForceSyncFrom(fromHeight uint64) error {
-	chainTip, err := d.ClientBlindBit.GetChainTip()
+	chainTip, err := d.Indexer.GetChainTip(d.ctx)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return err
@@ -253,7 +269,7 @@ func (d *Daemon) ForceSyncFrom(fromHeight uint64) error {
 		// possible logging here to indicate to the user
 		logging.DebugLogger.Println("syncing:", i)
 		var ownedUTXOs []*src.OwnedUTXO
-		ownedUTXOs, err = d.syncBlock(i)
+		ownedUTXOs, err = d.syncBlock(i, chainTip)
 		if err != nil {
 			logging.ErrorLogger.Println(err)
 			return err
@@ -288,28 +304,49 @@ func (d *Daemon) ForceSyncFrom(fromHeight uint64) error {
 	return err
 }
 
+// ContinuousScan
+// scans every new block until the daemon shuts down. Failed scans, e.g. while the indexer is unreachable,
+// are retried with the next block or tick. Only the blocks after LastScanHeight are rescanned.
 func (d *Daemon) ContinuousScan() error {
 	d.Status = pb.Status_STATUS_SCANNING
 	for {
 		select {
+		case <-d.ctx.Done():
+			return nil
 		case newBlock := <-d.NewBlockChan:
 			<-time.After(5 * time.Second) // delay, indexing server does not index immediately after a block is found
 			oldBalance := d.Wallet.FreeBalance()
 			err := d.SyncToTip(uint64(newBlock.Height))
 			if err != nil {
-				logging.ErrorLogger.Println(err)
-				return err
+				if d.ctx.Err() != nil {
+					return nil
+				}
+				logScanFailure(err)
+				continue
 			}
 			newBalance := d.Wallet.FreeBalance()
 			if oldBalance != newBalance {
 				logging.InfoLogger.Printf("New balance: %d\n", newBalance)
 			}
+			err = d.executeDueSchedules()
+			if err != nil {
+				// the schedules are checked again with the next block or tick
+				logging.ErrorLogger.Println(err)
+			}
+			err = d.rebroadcastPendingTransactions()
+			if err != nil {
+				// retried with the next block or tick
+				logging.ErrorLogger.Println(err)
+			}
 		case height := <-d.TriggerRescanChan:
 			oldBalance := d.Wallet.FreeBalance()
 			err := d.ForceSyncFrom(height)
 			if err != nil {
-				logging.ErrorLogger.Println(err)
-				return err
+				if d.ctx.Err() != nil {
+					return nil
+				}
+				logScanFailure(err)
+				continue
 			}
 			newBalance := d.Wallet.FreeBalance()
 			if oldBalance != newBalance {
@@ -318,32 +355,56 @@ func (d *Daemon) ContinuousScan() error {
 		case <-time.NewTicker(src.AutomaticScanInterval).C:
 			// todo is this needed if NewBlockChan is very robust?
 			// check every 5 minutes anyway
-			chainTip, err := d.ClientBlindBit.GetChainTip()
-			if err != nil {
-				logging.ErrorLogger.Println(err)
-				return err
+			chainTip, err := d.Indexer.GetChainTip(d.ctx)
+			if err == nil && chainTip <= d.Wallet.LastScanHeight {
+				continue
 			}
-
-			if chainTip <= d.Wallet.LastScanHeight {
+			if err == nil {
+				err = d.SyncToTip(chainTip)
+			}
+			if err != nil {
+				if d.ctx.Err() != nil {
+					return nil
+				}
+				logScanFailure(err)
 				continue
 			}
-
-			err = d.SyncToTip(chainTip)
+		case notif := <-d.accountNotifChan:
+			// a lost Electrum connection is renewed in the background, the status is pushed again on resubscription
+			err := d.syncAccountScript(notif.Params[0])
 			if err != nil {
 				logging.ErrorLogger.Println(err)
-				return err
 			}
 		case <-time.NewTicker(1 * time.Minute).C:
-			// exclusively to check for spent UTXOs
+			// check for spent UTXOs, time based schedules and transactions to rebroadcast
 			err := d.CheckUnspentUTXOs()
 			if err != nil {
+				// retried with the next tick
+				logging.ErrorLogger.Println(err)
+			}
+			err = d.executeDueSchedules()
+			if err != nil {
+				// the schedules are checked again with the next block or tick
+				logging.ErrorLogger.Println(err)
+			}
+			err = d.rebroadcastPendingTransactions()
+			if err != nil {
+				// retried with the next block or tick
 				logging.ErrorLogger.Println(err)
-				return err
 			}
 		}
 	}
 }
 
+// logScanFailure logs a failed scan which is retried later
+func logScanFailure(err error) {
+	if errors.Is(err, src.ErrIndexerUnavailable) {
+		logging.WarningLogger.Printf("indexer is unavailable, retrying later: %s\n", err)
+		return
+	}
+	logging.ErrorLogger.Printf("scan failed, retrying later: %s\n", err)
+}
+
 // CheckUnspentUTXOs
 // checks against electrum whether unspent owned UTXOs are now unspent
 func (d *Daemon) CheckUnspentUTXOs() error {
@@ -351,10 +412,15 @@ func (d *Daemon) CheckUnspentUTXOs() error {
 		// we don't use Electrum if set to false
 		return nil
 	}
+	client, err := d.Electrum.Client()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
 	// todo this probably breaks if more than one UTXO are locked to a script
 	//  this should never happen if the protocol is followed but still might occur
 	for _, utxo := range d.Wallet.GetUTXOsByStates(src.StateUnspent, src.StateUnconfirmedSpent) {
-		balance, err := d.ClientElectrum.GetBalance(context.Background(), utils.ConvertPubKeyToScriptHash(utxo.PubKey))
+		balance, err := client.GetBalance(context.Background(), utils.ConvertPkScriptToScriptHash(utxo.ScriptPubKey()))
 		if err != nil {
 			logging.ErrorLogger.Println(err)
 			return err
@@ -373,7 +439,7 @@ func (d *Daemon) CheckUnspentUTXOs() error {
 
 func (d *Daemon) MarkSpentUTXOs(blockHeight uint64) error {
 	// move SpentOutpointsIndex to types
-	filter, err := d.ClientBlindBit.GetFilter(blockHeight, networking.SpentOutpointsFilterType)
+	filter, err := d.Indexer.GetFilter(d.ctx, blockHeight, networking.SpentOutpointsFilterType)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return err
@@ -398,7 +464,7 @@ func (d *Daemon) MarkSpentUTXOs(blockHeight uint64) error {
 		return nil
 	}
 
-	index, err := d.ClientBlindBit.GetSpentOutpointsIndex(blockHeight)
+	index, err := d.Indexer.GetSpentOutpointsIndex(d.ctx, blockHeight)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return err
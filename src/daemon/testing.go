@@ -39,7 +39,7 @@ func (d *Daemon) RunTests() {
 	if d.Wallet == nil {
 		panic("wallet not set")
 	}
-	if d.ClientBlindBit == nil {
+	if d.Indexer == nil {
 		panic("client not set")
 	}
 
@@ -107,7 +107,7 @@ func (d *Daemon) RunTests() {
 			Amount:     int64(d.Wallet.UTXOs[0].Amount / 4),
 			Annotation: "paying myself on a label",
 		},
-	}, 10_000, false, false)
+	}, src.FeeTarget{FeeRateMilliSats: 10_000_000}, false, false)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,99 @@
+package src
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	ElectrumSchemeTCP = "tcp"
+	ElectrumSchemeSSL = "ssl"
+)
+
+// ElectrumServer
+// an Electrum endpoint as configured: `host:port` or `tcp://host:port` for plaintext,
+// `ssl://host:port` for TLS. A self-signed certificate is pinned with `ssl://host:port?fingerprint=<sha256 hex>`.
+type ElectrumServer struct {
+	Address string // host:port
+	TLS     bool
+	// Fingerprint SHA-256 of the DER encoded server certificate. If set, the certificate is not checked against the CAs.
+	Fingerprint []byte
+}
+
+func (s ElectrumServer) String() string {
+	if s.TLS {
+		return ElectrumSchemeSSL + "://" + s.Address
+	}
+	return ElectrumSchemeTCP + "://" + s.Address
+}
+
+// ParseElectrumServer parses an endpoint, see ElectrumServer
+func ParseElectrumServer(endpoint string) (ElectrumServer, error) {
+	var server ElectrumServer
+
+	scheme, rest, found := strings.Cut(endpoint, "://")
+	if !found {
+		scheme, rest = ElectrumSchemeTCP, endpoint
+	}
+	address, rawQuery, _ := strings.Cut(rest, "?")
+
+	switch strings.ToLower(scheme) {
+	case ElectrumSchemeTCP:
+	case ElectrumSchemeSSL:
+		server.TLS = true
+	default:
+		return server, fmt.Errorf("electrum server %q: unknown scheme %q", endpoint, scheme)
+	}
+
+	_, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return server, fmt.Errorf("electrum server %q: %w", endpoint, err)
+	}
+	server.Address = address
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return server, fmt.Errorf("electrum server %q: %w", endpoint, err)
+	}
+	for key := range query {
+		if key != "fingerprint" {
+			return server, fmt.Errorf("electrum server %q: unknown parameter %q", endpoint, key)
+		}
+	}
+	if fingerprint := query.Get("fingerprint"); fingerprint != "" {
+		if !server.TLS {
+			return server, fmt.Errorf("electrum server %q: a fingerprint needs an ssl:// server", endpoint)
+		}
+		// fingerprints are commonly shown with colons, e.g. by openssl
+		server.Fingerprint, err = hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+		if err != nil || len(server.Fingerprint) != 32 {
+			return server, fmt.Errorf("electrum server %q: fingerprint has to be a hex encoded SHA-256 hash", endpoint)
+		}
+	}
+
+	return server, nil
+}
+
+// loadElectrumServers
+// parses the endpoints and checks them against the tor and TLS settings
+func loadElectrumServers(endpoints []string, torProxyHost string, requireTLS bool) ([]ElectrumServer, error) {
+	servers := make([]ElectrumServer, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		server, err := ParseElectrumServer(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if server.TLS && torProxyHost != "" {
+			// the Electrum client only opens TLS connections directly, this would bypass tor
+			return nil, fmt.Errorf("electrum server %s: ssl servers can't be reached through tor, use tcp:// or disable electrum_tor", server)
+		}
+		if !server.TLS && torProxyHost == "" && requireTLS {
+			return nil, fmt.Errorf("electrum server %s: electrum_require_tls needs an ssl:// server if tor is not used", server)
+		}
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
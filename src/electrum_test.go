@@ -0,0 +1,89 @@
+package src
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParseElectrumServer(t *testing.T) {
+	fingerprint := strings.Repeat("ab", 32)
+
+	testCases := []struct {
+		name     string
+		endpoint string
+		target   ElectrumServer
+		invalid  bool
+	}{
+		{
+			name:     "plain",
+			endpoint: "localhost:50001",
+			target:   ElectrumServer{Address: "localhost:50001"},
+		},
+		{
+			name:     "tcp",
+			endpoint: "tcp://electrum.example.com:50001",
+			target:   ElectrumServer{Address: "electrum.example.com:50001"},
+		},
+		{
+			name:     "ssl",
+			endpoint: "ssl://electrum.example.com:50002",
+			target:   ElectrumServer{Address: "electrum.example.com:50002", TLS: true},
+		},
+		{
+			name:     "pinned",
+			endpoint: "SSL://[::1]:50002?fingerprint=" + strings.ToUpper(fingerprint),
+			target:   ElectrumServer{Address: "[::1]:50002", TLS: true, Fingerprint: bytes.Repeat([]byte{0xab}, 32)},
+		},
+		{
+			name:     "pinned with colons",
+			endpoint: "ssl://electrum.example.com:50002?fingerprint=" + strings.Repeat("ab:", 31) + "ab",
+			target:   ElectrumServer{Address: "electrum.example.com:50002", TLS: true, Fingerprint: bytes.Repeat([]byte{0xab}, 32)},
+		},
+		{name: "missing port", endpoint: "ssl://electrum.example.com", invalid: true},
+		{name: "unknown scheme", endpoint: "http://electrum.example.com:50001", invalid: true},
+		{name: "fingerprint without tls", endpoint: "tcp://electrum.example.com:50001?fingerprint=" + fingerprint, invalid: true},
+		{name: "short fingerprint", endpoint: "ssl://electrum.example.com:50002?fingerprint=abab", invalid: true},
+		{name: "unknown parameter", endpoint: "ssl://electrum.example.com:50002?pin=" + fingerprint, invalid: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, err := ParseElectrumServer(tc.endpoint)
+			if tc.invalid {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", server)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if server.Address != tc.target.Address || server.TLS != tc.target.TLS || !bytes.Equal(server.Fingerprint, tc.target.Fingerprint) {
+				t.Fatalf("got %+v, expected %+v", server, tc.target)
+			}
+		})
+	}
+}
+
+func TestLoadElectrumServers(t *testing.T) {
+	endpoints := []string{"ssl://electrum.example.com:50002", "electrum.example.com:50001"}
+
+	_, err := loadElectrumServers(endpoints, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = loadElectrumServers(endpoints, "", true)
+	if err == nil {
+		t.Fatal("plaintext server accepted although TLS is required")
+	}
+	// through tor TLS is not required, but it can't be used either
+	_, err = loadElectrumServers(endpoints[1:], "127.0.0.1:9050", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = loadElectrumServers(endpoints[:1], "127.0.0.1:9050", false)
+	if err == nil {
+		t.Fatal("ssl server accepted with tor")
+	}
+}
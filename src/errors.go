@@ -31,5 +31,57 @@ var (
 
 	ErrInvalidFeeRate = errors.New("invalid fee rate")
 
+	ErrInvalidAbsoluteFee = errors.New("invalid absolute fee")
+
+	ErrInvalidConfTarget = errors.New("invalid confirmation target")
+
+	ErrNoFeeEstimateAvailable = errors.New("no fee estimate available")
+
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	ErrFeeRateTooLowForReplacement = errors.New("fee too low for replacement")
+
+	ErrNoOwnedOutputsInTransaction = errors.New("transaction has no outputs owned by this wallet")
+
+	ErrPackageFeeRateAlreadyReached = errors.New("transaction already pays the target fee rate")
+
+	ErrNoAccountKeys = errors.New("wallet has no keys for regular accounts, recover the wallet from seed to enable them")
+
 	ErrRecipientAmountIsZero = errors.New("recipient amount is zero")
+
+	ErrDuplicateRecipient = errors.New("duplicate recipient")
+
+	ErrAmountBelowDust = errors.New("amount below dust limit")
+
+	ErrAddressNetworkMismatch = errors.New("address is for a different network")
+
+	ErrScheduleNotFound = errors.New("schedule not found")
+
+	ErrInvalidScheduleTiming = errors.New("schedule needs either a start height with an optional block interval or a start time with an optional time interval")
+
+	ErrScheduleFeeCapExceeded = errors.New("fee exceeds the cap of the schedule")
+
+	ErrPolicyViolation = errors.New("transaction violates the spending policy")
+
+	ErrInvalidPaymentURI = errors.New("invalid payment uri")
+
+	ErrTransactionNotAbandonable = errors.New("transaction can't be abandoned")
+
+	ErrNoBroadcastBackend = errors.New("no broadcast backend configured; either configure one in [broadcast] or publish on another channel")
+
+	ErrIndexerNotFound = errors.New("indexer has no data for the request")
+
+	ErrIndexerUnavailable = errors.New("indexer is unavailable")
+
+	ErrIndexerBadResponse = errors.New("indexer sent an invalid response")
+
+	ErrIndexerResponseTooLarge = errors.New("indexer response exceeds the size limit")
+
+	ErrIndexerDivergence = errors.New("indexers serve divergent data")
+
+	ErrPrevOutUnknown = errors.New("spent output of an input could not be determined")
+
+	ErrElectrumUnavailable = errors.New("no connection to an Electrum server")
+
+	ErrElectrumCertificateMismatch = errors.New("certificate of the Electrum server does not match the pinned fingerprint")
 )
@@ -1,10 +1,14 @@
 package ipc
 
 import (
+	"time"
+
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/setavenger/blindbitd/pb"
 	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/daemon"
 	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/networking"
 	"github.com/setavenger/blindbitd/src/utils"
 	"github.com/setavenger/go-bip352"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -26,6 +30,7 @@ func convertWalletUTXOs(utxos []*src.OwnedUTXO, mapping src.LabelsMapping) []*pb
 			TimestampConfirmed: &timestamppb.Timestamp{Seconds: int64(utxo.Timestamp)},
 			UtxoState:          convertState(utxo.State),
 			Label:              label,
+			Source:             convertSource(utxo.ScriptType),
 		})
 	}
 
@@ -69,6 +74,17 @@ func convertState(state src.UTXOState) pb.UTXOState {
 	}
 }
 
+func convertSource(scriptType src.ScriptType) pb.UTXOSource {
+	switch scriptType {
+	case src.ScriptTypeP2TR:
+		return pb.UTXOSource_SOURCE_TAPROOT_ACCOUNT
+	case src.ScriptTypeP2WPKH:
+		return pb.UTXOSource_SOURCE_SEGWIT_ACCOUNT
+	default:
+		return pb.UTXOSource_SOURCE_SILENT_PAYMENT
+	}
+}
+
 func convertToRecipients(recipients []*pb.TransactionRecipient) []*src.Recipient {
 	var convertedRecipients []*src.Recipient
 
@@ -83,6 +99,171 @@ func convertToRecipients(recipients []*pb.TransactionRecipient) []*src.Recipient
 	return convertedRecipients
 }
 
+// convertToFeeTarget
+// absoluteFee takes precedence over feeRateMilliSats which takes precedence over the integer feeRate.
+func convertToFeeTarget(in *pb.CreateTransactionRequest) (src.FeeTarget, error) {
+	switch {
+	case in.AbsoluteFee > 0:
+		return src.FeeTarget{AbsoluteFee: in.AbsoluteFee}, nil
+	case in.FeeRateMilliSats > 0:
+		return src.FeeTarget{FeeRateMilliSats: in.FeeRateMilliSats}, nil
+	case in.FeeRate > 0:
+		return src.FeeTarget{FeeRateMilliSats: uint64(in.FeeRate) * 1000}, nil
+	default:
+		return src.FeeTarget{}, src.ErrInvalidFeeRate
+	}
+}
+
+func convertFeeEstimate(estimate *daemon.FeeEstimate) *pb.FeeEstimate {
+	return &pb.FeeEstimate{
+		ConfTarget:       estimate.ConfTarget,
+		FeeRateMilliSats: estimate.FeeRateMilliSats,
+		Source:           estimate.Source,
+		Timestamp:        timestamppb.New(estimate.Timestamp),
+	}
+}
+
+func convertTransactionPreview(preview *daemon.TransactionPreview, mapping src.LabelsMapping) *pb.TransactionPreview {
+	var outputs []*pb.PreviewOutput
+	for _, output := range preview.Outputs {
+		outputs = append(outputs, &pb.PreviewOutput{
+			Address:  output.Address,
+			PkScript: output.PkScript,
+			Amount:   output.Amount,
+			IsChange: output.IsChange,
+		})
+	}
+
+	return &pb.TransactionPreview{
+		Inputs:           convertWalletUTXOs(preview.Inputs, mapping),
+		Outputs:          outputs,
+		VsizeEstimate:    preview.VSizeEstimate,
+		Fee:              preview.Fee,
+		FeeRateMilliSats: preview.FeeRateMilliSats,
+		MarkedSpent:      convertWalletUTXOs(preview.MarkedSpent, mapping),
+	}
+}
+
+func convertToSchedule(in *pb.Schedule) *src.Schedule {
+	schedule := &src.Schedule{
+		Recipients:          convertToRecipients(in.Recipients),
+		Comment:             in.Comment,
+		StartHeight:         in.StartHeight,
+		IntervalBlocks:      in.IntervalBlocks,
+		Interval:            time.Duration(in.IntervalSeconds) * time.Second,
+		IntervalMonths:      in.IntervalMonths,
+		FeeRateMilliSats:    in.FeeRateMilliSats,
+		ConfTarget:          in.ConfTarget,
+		MaxFeeRateMilliSats: in.MaxFeeRateMilliSats,
+		MaxFee:              in.MaxFee,
+	}
+	if in.StartTime != nil {
+		schedule.StartTime = in.StartTime.AsTime()
+	}
+	return schedule
+}
+
+func convertSchedule(schedule *src.Schedule) *pb.Schedule {
+	var recipients []*pb.TransactionRecipient
+	for _, recipient := range schedule.Recipients {
+		recipients = append(recipients, &pb.TransactionRecipient{
+			Address:    recipient.Address,
+			Amount:     uint64(recipient.Amount),
+			Annotation: recipient.Annotation,
+		})
+	}
+
+	var executions []*pb.ScheduleExecution
+	for _, execution := range schedule.Executions {
+		executions = append(executions, &pb.ScheduleExecution{
+			Timestamp:        timestamppb.New(execution.Timestamp),
+			Height:           execution.Height,
+			Txid:             execution.Txid,
+			Fee:              execution.Fee,
+			FeeRateMilliSats: execution.FeeRateMilliSats,
+			Error:            execution.Error,
+		})
+	}
+
+	result := &pb.Schedule{
+		Id:                  schedule.Id,
+		Recipients:          recipients,
+		Comment:             schedule.Comment,
+		StartHeight:         schedule.StartHeight,
+		IntervalBlocks:      schedule.IntervalBlocks,
+		IntervalSeconds:     uint64(schedule.Interval / time.Second),
+		IntervalMonths:      schedule.IntervalMonths,
+		FeeRateMilliSats:    schedule.FeeRateMilliSats,
+		ConfTarget:          schedule.ConfTarget,
+		MaxFeeRateMilliSats: schedule.MaxFeeRateMilliSats,
+		MaxFee:              schedule.MaxFee,
+		Active:              schedule.Active,
+		NextHeight:          schedule.NextHeight,
+		Executions:          executions,
+	}
+	if !schedule.StartTime.IsZero() {
+		result.StartTime = timestamppb.New(schedule.StartTime)
+		result.NextTime = timestamppb.New(schedule.NextTime)
+	}
+	return result
+}
+
+func convertAddressInfo(info *src.AddressInfo) *pb.AddressInfo {
+	return &pb.AddressInfo{
+		Address:            info.Address,
+		IsValid:            info.IsValid,
+		Error:              info.Error,
+		IsSilentPayment:    info.IsSilentPayment,
+		Network:            info.Network,
+		IsForWalletNetwork: info.IsForWalletNetwork,
+		Version:            uint32(info.Version),
+		ScanPubKey:         info.ScanPubKey,
+		SpendPubKey:        info.SpendPubKey,
+		LabelM:             info.LabelM,
+		ScriptType:         info.ScriptType,
+		PkScript:           info.PkScript,
+		IsMine:             info.IsMine,
+		Comment:            info.Comment,
+	}
+}
+
+func convertOutgoingTransaction(tx *src.OutgoingTransaction) *pb.OutgoingTransaction {
+	var amount uint64
+	for _, recipient := range tx.Recipients {
+		amount += uint64(recipient.Amount)
+	}
+
+	result := &pb.OutgoingTransaction{
+		Txid:             tx.Txid,
+		State:            convertOutgoingTxState(tx.State),
+		Fee:              tx.Fee,
+		FeeRateMilliSats: tx.FeeRateMilliSats,
+		Amount:           amount,
+		Created:          timestamppb.New(time.Unix(int64(tx.Timestamp), 0)),
+		External:         tx.External,
+	}
+	if tx.FirstBroadcast > 0 {
+		result.FirstBroadcast = timestamppb.New(time.Unix(int64(tx.FirstBroadcast), 0))
+		result.LastBroadcast = timestamppb.New(time.Unix(int64(tx.LastBroadcast), 0))
+	}
+	return result
+}
+
+func convertOutgoingTxState(state src.OutgoingTxState) pb.OutgoingTxState {
+	switch state {
+	case src.OutgoingTxPending:
+		return pb.OutgoingTxState_TX_PENDING
+	case src.OutgoingTxConfirmed:
+		return pb.OutgoingTxState_TX_CONFIRMED
+	case src.OutgoingTxDropped:
+		return pb.OutgoingTxState_TX_DROPPED
+	case src.OutgoingTxAbandoned:
+		return pb.OutgoingTxState_TX_ABANDONED
+	default:
+		return pb.OutgoingTxState_TX_CREATED
+	}
+}
+
 func convertChainParam(params *chaincfg.Params) *pb.Chain {
 	var chain pb.Chain
 
@@ -99,3 +280,25 @@ func convertChainParam(params *chaincfg.Params) *pb.Chain {
 
 	return &chain
 }
+
+func convertElectrumStatus(status networking.ElectrumStatus) *pb.ElectrumStatus {
+	result := &pb.ElectrumStatus{
+		Reconnects: status.Reconnects,
+	}
+	if status.Server.Address != "" {
+		result.Server = status.Server.String()
+	}
+	switch status.State {
+	case networking.ElectrumConnecting:
+		result.State = pb.ElectrumConnectionState_ELECTRUM_CONNECTING
+	case networking.ElectrumConnected:
+		result.State = pb.ElectrumConnectionState_ELECTRUM_CONNECTED
+		result.ConnectedSince = timestamppb.New(status.ConnectedSince)
+	default:
+		result.State = pb.ElectrumConnectionState_ELECTRUM_DISCONNECTED
+	}
+	if status.LastError != nil {
+		result.LastError = status.LastError.Error()
+	}
+	return result
+}
@@ -29,7 +29,11 @@ func NewServer(d *daemon.Daemon) *Server {
 }
 
 func (s *Server) Status(_ context.Context, _ *pb.Empty) (*pb.StatusResponse, error) {
-	return &pb.StatusResponse{Status: s.Daemon.Status}, nil
+	response := &pb.StatusResponse{Status: s.Daemon.Status}
+	if s.Daemon.Electrum != nil {
+		response.Electrum = convertElectrumStatus(s.Daemon.Electrum.Status())
+	}
+	return response, nil
 }
 
 func (s *Server) SyncHeight(_ context.Context, _ *pb.Empty) (*pb.SyncHeightResponse, error) {
@@ -175,8 +179,11 @@ func (s *Server) CreateTransaction(_ context.Context, in *pb.CreateTransactionRe
 		return nil, src.ErrDaemonIsLocked
 	}
 	recipients := convertToRecipients(in.Recipients)
-	// todo UTXOs have to be marked as spent after creating the transaction; broadcast and mark as spent
-	signedTx, err := s.Daemon.SendToRecipients(recipients, in.FeeRate, in.MarkSpent, in.UseSpentUnconfirmed)
+	feeTarget, err := s.resolveFeeTarget(in)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := s.Daemon.SendToRecipients(recipients, feeTarget, in.MarkSpent, in.UseSpentUnconfirmed)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -189,12 +196,16 @@ func (s *Server) CreateTransactionAndBroadcast(_ context.Context, in *pb.CreateT
 		return nil, src.ErrDaemonIsLocked
 	}
 	recipients := convertToRecipients(in.Recipients)
-	// todo UTXOs have to be marked as spent after creating the transaction; broadcast and mark as spent
-	signedTx, err := s.Daemon.SendToRecipients(recipients, in.FeeRate, in.MarkSpent, in.UseSpentUnconfirmed)
+	feeTarget, err := s.resolveFeeTarget(in)
 	if err != nil {
 		return nil, err
 	}
-	txid, err := s.Daemon.BroadcastTx(signedTx)
+	signedTx, err := s.Daemon.SendToRecipients(recipients, feeTarget, in.MarkSpent, in.UseSpentUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+	// marks the inputs and adds our change right away instead of waiting for the next block
+	txid, err := s.Daemon.BroadcastRawTx(signedTx)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +232,7 @@ func (s *Server) BroadcastRawTx(_ context.Context, in *pb.RawTransaction) (*pb.N
 		return nil, src.ErrDaemonIsLocked
 	}
 
-	txid, err := s.Daemon.BroadcastTx(in.RawTx)
+	txid, err := s.Daemon.BroadcastRawTx(in.RawTx)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -368,6 +379,55 @@ func (s *Server) GetChain(_ context.Context, _ *pb.Empty) (*pb.Chain, error) {
 	return convertChainParam(src.ChainParams), nil
 }
 
+func (s *Server) BumpFee(_ context.Context, in *pb.BumpFeeRequest) (*pb.BumpFeeResponse, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	replacement, err := s.Daemon.BumpFee(in.Txid, in.FeeRateMilliSats)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	if in.Broadcast {
+		// marks the inputs and the replaced transaction once the replacement is out
+		_, err = s.Daemon.BroadcastRawTx(replacement.RawTx)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	}
+
+	return &pb.BumpFeeResponse{Txid: replacement.Txid, RawTx: replacement.RawTx, Fee: replacement.Fee}, nil
+}
+
+func (s *Server) EstimateFee(_ context.Context, in *pb.EstimateFeeRequest) (*pb.FeeEstimate, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	estimate, err := s.Daemon.EstimateFee(in.ConfTarget)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return convertFeeEstimate(estimate), nil
+}
+
+// resolveFeeTarget
+// uses the fee given in the request and only estimates a fee rate if a confirmation target is the only option given
+func (s *Server) resolveFeeTarget(in *pb.CreateTransactionRequest) (src.FeeTarget, error) {
+	if in.AbsoluteFee == 0 && in.FeeRateMilliSats == 0 && in.FeeRate == 0 && in.ConfTarget > 0 {
+		estimate, err := s.Daemon.EstimateFee(in.ConfTarget)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return src.FeeTarget{}, err
+		}
+		logging.InfoLogger.Printf("using estimated fee rate %d msat/vByte from %s for target %d\n", estimate.FeeRateMilliSats, estimate.Source, in.ConfTarget)
+		return src.FeeTarget{FeeRateMilliSats: estimate.FeeRateMilliSats}, nil
+	}
+	return convertToFeeTarget(in)
+}
+
 func (s *Server) Start() error {
 	if s.Daemon == nil {
 		return src.ErrDaemonNotSet
@@ -393,3 +453,146 @@ func (s *Server) Start() error {
 	}
 	return err
 }
+
+func (s *Server) CpfpBump(_ context.Context, in *pb.CpfpBumpRequest) (*pb.BumpFeeResponse, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	child, err := s.Daemon.CpfpBump(in.Txid, in.FeeRateMilliSats)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	if in.Broadcast {
+		_, err = s.Daemon.BroadcastRawTx(child.RawTx)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	}
+
+	return &pb.BumpFeeResponse{Txid: child.Txid, RawTx: child.RawTx, Fee: child.Fee}, nil
+}
+
+func (s *Server) PreviewTransaction(_ context.Context, in *pb.CreateTransactionRequest) (*pb.TransactionPreview, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	recipients := convertToRecipients(in.Recipients)
+	feeTarget, err := s.resolveFeeTarget(in)
+	if err != nil {
+		return nil, err
+	}
+	preview, err := s.Daemon.PreviewTransaction(recipients, feeTarget, in.MarkSpent, in.UseSpentUnconfirmed)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return convertTransactionPreview(preview, s.Daemon.Wallet.LabelsMapping), nil
+}
+
+func (s *Server) ImportUTXO(_ context.Context, in *pb.ImportUTXORequest) (*pb.OwnedUTXO, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	utxo, err := s.Daemon.ImportUTXO(in.Txid, in.Vout)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return convertWalletUTXOs(src.UtxoCollection{utxo}, s.Daemon.Wallet.LabelsMapping)[0], nil
+}
+
+func (s *Server) NewTaprootAddress(_ context.Context, in *pb.NewLabelRequest) (*pb.Address, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	address, err := s.Daemon.NewTaprootAddress(in.Comment)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return &pb.Address{Address: address.Address, Comment: address.Comment}, nil
+}
+
+func (s *Server) ListTaprootAddresses(_ context.Context, _ *pb.Empty) (*pb.AddressesCollection, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+
+	var addressCollection pb.AddressesCollection
+	for _, address := range s.Daemon.Wallet.TaprootAddresses {
+		addressCollection.Addresses = append(addressCollection.Addresses, &pb.Address{
+			Address: address.Address,
+			Comment: address.Comment,
+		})
+	}
+	return &addressCollection, nil
+}
+
+func (s *Server) CreateSchedule(_ context.Context, in *pb.Schedule) (*pb.Schedule, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	schedule, err := s.Daemon.CreateSchedule(convertToSchedule(in))
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return convertSchedule(schedule), nil
+}
+
+func (s *Server) ListSchedules(_ context.Context, _ *pb.Empty) (*pb.SchedulesCollection, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+
+	var schedulesCollection pb.SchedulesCollection
+	for _, schedule := range s.Daemon.ListSchedules() {
+		schedulesCollection.Schedules = append(schedulesCollection.Schedules, convertSchedule(schedule))
+	}
+	return &schedulesCollection, nil
+}
+
+func (s *Server) DeleteSchedule(_ context.Context, in *pb.DeleteScheduleRequest) (*pb.BoolResponse, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	err := s.Daemon.DeleteSchedule(in.Id)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return &pb.BoolResponse{Success: false, Error: err.Error()}, err
+	}
+	return &pb.BoolResponse{Success: true}, nil
+}
+
+func (s *Server) ValidateAddress(_ context.Context, in *pb.ValidateAddressRequest) (*pb.AddressInfo, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	return convertAddressInfo(s.Daemon.Wallet.InspectAddress(in.Address, src.ChainParams)), nil
+}
+
+func (s *Server) ListPendingTransactions(_ context.Context, _ *pb.Empty) (*pb.OutgoingTransactionsCollection, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	var transactions []*pb.OutgoingTransaction
+	for _, tx := range s.Daemon.ListPendingTransactions() {
+		transactions = append(transactions, convertOutgoingTransaction(tx))
+	}
+	return &pb.OutgoingTransactionsCollection{Transactions: transactions}, nil
+}
+
+func (s *Server) AbandonTransaction(_ context.Context, in *pb.AbandonTransactionRequest) (*pb.BoolResponse, error) {
+	if s.Daemon.Locked {
+		return nil, src.ErrDaemonIsLocked
+	}
+	err := s.Daemon.AbandonTransaction(in.Txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return &pb.BoolResponse{Success: false, Error: err.Error()}, err
+	}
+	return &pb.BoolResponse{Success: true}, nil
+}
@@ -13,8 +13,17 @@ type Keys struct {
 	ScanSecretKey  [32]byte
 	SpendSecretKey [32]byte
 	Mnemonic       string
+	// AccountKeyP2WPKH and AccountKeyP2TR are the extended private keys (xprv) of the regular BIP 84 and BIP 86 accounts.
+	// They are empty for wallets which were created before those accounts were introduced.
+	AccountKeyP2WPKH string `json:",omitempty"`
+	AccountKeyP2TR   string `json:",omitempty"`
 }
 
+const (
+	PurposeP2WPKH uint32 = 84 // BIP 84
+	PurposeP2TR   uint32 = 86 // BIP 86
+)
+
 func (k *Keys) Serialise() ([]byte, error) {
 	return json.Marshal(k)
 }
@@ -70,6 +79,20 @@ func KeysFromMnemonic(mnemonic, seedPassphrase string) (*Keys, error) {
 	result.ScanSecretKey = keys.ScanSecretKey
 	result.SpendSecretKey = keys.SpendSecretKey
 
+	accountP2WPKH, err := DeriveAccountKeyFromMaster(master, PurposeP2WPKH)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	result.AccountKeyP2WPKH = accountP2WPKH.String()
+
+	accountP2TR, err := DeriveAccountKeyFromMaster(master, PurposeP2TR)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	result.AccountKeyP2TR = accountP2TR.String()
+
 	return &result, err
 
 }
@@ -155,3 +178,32 @@ func DeriveKeysFromMaster(master *hdkeychain.ExtendedKey) (*Keys, error) {
 		SpendSecretKey: bip352.ConvertToFixedLength32(secretKeySpend.Serialize()),
 	}, nil
 }
+
+// DeriveAccountKeyFromMaster
+// derives the first account m/purpose'/coin'/0' for the regular (non SP) wallet standards
+func DeriveAccountKeyFromMaster(master *hdkeychain.ExtendedKey, purpose uint32) (*hdkeychain.ExtendedKey, error) {
+	purposeKey, err := master.Derive(purpose + hdkeychain.HardenedKeyStart)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	var coinType uint32 = 1
+	if ChainParams.Name == "mainnet" {
+		coinType = 0
+	}
+
+	coinTypeKey, err := purposeKey.Derive(coinType + hdkeychain.HardenedKeyStart)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	account, err := coinTypeKey.Derive(0 + hdkeychain.HardenedKeyStart)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	return account, nil
+}
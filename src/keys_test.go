@@ -1,10 +1,15 @@
 package src
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/setavenger/blindbitd/src/logging"
 )
 
 type item struct {
@@ -31,6 +36,7 @@ var testData = []item{
 
 func init() {
 	ChainParams = &chaincfg.MainNetParams
+	logging.LoadLoggersMock()
 }
 
 func TestKeysFromMnemonic(t *testing.T) {
@@ -87,3 +93,124 @@ func TestCreateNewKeys(t *testing.T) {
 		}
 	}
 }
+
+// TestAccountKeys checks the regular accounts against the test vectors of BIP 84 and BIP 86
+func TestAccountKeys(t *testing.T) {
+	keys, err := KeysFromMnemonic(testData[0].mnemonic, testData[0].passphrase)
+	if err != nil {
+		t.Errorf("error deriving keys: %v", err)
+		return
+	}
+
+	wallet := NewWallet(0)
+	err = wallet.LoadAccountKeys(keys)
+	if err != nil {
+		t.Errorf("error loading account keys: %v", err)
+		return
+	}
+
+	targets := []struct {
+		scriptType ScriptType
+		path       DerivationPath
+		address    string
+	}{
+		{ScriptTypeP2WPKH, DerivationPath{Change: 0, Index: 0}, "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"},
+		{ScriptTypeP2WPKH, DerivationPath{Change: 1, Index: 0}, "bc1q8c6fshw2dlwun7ekn9qwf37cu2rn755upcp6el"},
+		{ScriptTypeP2TR, DerivationPath{Change: 0, Index: 0}, "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"},
+		{ScriptTypeP2TR, DerivationPath{Change: 1, Index: 0}, "bc1p3qkhfews2uk44qtvauqyr2ttdsw7svhkl9nkm9s9c3x4ax5h60wqwruhk7"},
+	}
+
+	for _, target := range targets {
+		address, err := btcutil.DecodeAddress(target.address, ChainParams)
+		if err != nil {
+			t.Errorf("error decoding address: %v", err)
+			return
+		}
+		pkScriptTarget, err := txscript.PayToAddrScript(address)
+		if err != nil {
+			t.Errorf("error creating script: %v", err)
+			return
+		}
+
+		pkScript, err := wallet.DeriveAccountPkScript(target.scriptType, &target.path)
+		if err != nil {
+			t.Errorf("error deriving script: %v", err)
+			return
+		}
+		if !bytes.Equal(pkScript, pkScriptTarget) {
+			t.Errorf("wrong script for %s: expected %x, got %x", target.address, pkScriptTarget, pkScript)
+			return
+		}
+
+		scriptType, path, err := wallet.FindDerivationPath(pkScriptTarget, 5)
+		if err != nil {
+			t.Errorf("error finding derivation path: %v", err)
+			return
+		}
+		if scriptType != target.scriptType || *path != target.path {
+			t.Errorf("wrong derivation path for %s: got %d %v", target.address, scriptType, *path)
+			return
+		}
+
+		// the signing key has to match the output
+		vin, err := wallet.ConvertUTXOIntoSigningVin(&OwnedUTXO{ScriptType: scriptType, PkScript: pkScript, DerivationPath: path})
+		if err != nil {
+			t.Errorf("error creating vin: %v", err)
+			return
+		}
+		_, pubKey := btcec.PrivKeyFromBytes(vin.SecretKey[:])
+		var pkScriptFromKey []byte
+		if scriptType == ScriptTypeP2TR {
+			pkScriptFromKey, err = txscript.PayToTaprootScript(pubKey)
+		} else {
+			pkScriptFromKey, err = txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(btcutil.Hash160(pubKey.SerializeCompressed())).Script()
+		}
+		if err != nil {
+			t.Errorf("error creating script: %v", err)
+			return
+		}
+		if !bytes.Equal(pkScriptFromKey, pkScript) {
+			t.Errorf("signing key does not match output for %s", target.address)
+			return
+		}
+	}
+}
+
+func TestGenerateTaprootAddress(t *testing.T) {
+	keys, err := KeysFromMnemonic(testData[0].mnemonic, testData[0].passphrase)
+	if err != nil {
+		t.Errorf("error deriving keys: %v", err)
+		return
+	}
+
+	wallet := NewWallet(0)
+	err = wallet.LoadAccountKeys(keys)
+	if err != nil {
+		t.Errorf("error loading account keys: %v", err)
+		return
+	}
+
+	address, err := wallet.GenerateTaprootAddress("exchange")
+	if err != nil {
+		t.Errorf("error generating address: %v", err)
+		return
+	}
+	if address.Address != "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr" {
+		t.Errorf("wrong first address: %s", address.Address)
+		return
+	}
+
+	second, err := wallet.GenerateTaprootAddress("")
+	if err != nil {
+		t.Errorf("error generating address: %v", err)
+		return
+	}
+	if second.Path.Index != 1 || second.Address == address.Address {
+		t.Errorf("second address was not derived at the next index: %v", second.Path)
+		return
+	}
+
+	if wallet.FindAccountAddressByPkScript(address.PkScript) != address {
+		t.Errorf("address was not found by its script")
+	}
+}
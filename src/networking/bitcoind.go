@@ -0,0 +1,132 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// ClientBitcoind
+// implements Indexer on top of a Bitcoin Core node. Blocks are fetched with `getblock` verbosity 3, which includes
+// the prevouts (Bitcoin Core 25.0 or newer), and tweaks, UTXOs and filters are computed locally in the same format
+// as BlindBit Oracle serves them. Nothing is requested from an external indexer.
+type ClientBitcoind struct {
+	localIndexer
+	RPC *BitcoindRPC
+
+	blocks blockCache
+}
+
+func NewClientBitcoind(rpc *BitcoindRPC) *ClientBitcoind {
+	c := &ClientBitcoind{RPC: rpc}
+	c.localIndexer = localIndexer{block: c.blockData}
+	return c
+}
+
+// bitcoindBlock is the part of `getblock` verbosity 3 which is needed
+type bitcoindBlock struct {
+	Hash string `json:"hash"`
+	Time uint64 `json:"time"`
+	Tx   []struct {
+		Hex string `json:"hex"`
+		Vin []struct {
+			Coinbase string `json:"coinbase"`
+			Prevout  *struct {
+				Value        float64 `json:"value"`
+				ScriptPubKey struct {
+					Hex string `json:"hex"`
+				} `json:"scriptPubKey"`
+			} `json:"prevout"`
+		} `json:"vin"`
+	} `json:"tx"`
+}
+
+func (c *ClientBitcoind) GetChainTip(ctx context.Context) (uint64, error) {
+	var height uint64
+	err := c.RPC.Call(ctx, "getblockcount", nil, &height)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return 0, err
+	}
+	return height, nil
+}
+
+// blockData
+// returns the processed block at blockHeight. The hash is always looked up, so a reorged block is not served from memory.
+func (c *ClientBitcoind) blockData(ctx context.Context, blockHeight uint64) (*processedBlock, error) {
+	var hashStr string
+	err := c.RPC.Call(ctx, "getblockhash", []any{blockHeight}, &hashStr)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	hash, err := chainhash.NewHashFromStr(hashStr)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	if data, ok := c.blocks.get(blockHeight, *hash); ok {
+		return data, nil
+	}
+
+	var block bitcoindBlock
+	err = c.RPC.Call(ctx, "getblock", []any{hashStr, 3}, &block)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	data, err := processBitcoindBlock(blockHeight, *hash, &block)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	c.blocks.put(blockHeight, data)
+	return data, nil
+}
+
+// processBitcoindBlock decodes the transactions and prevouts of a `getblock` result and processes the block
+func processBitcoindBlock(blockHeight uint64, hash chainhash.Hash, block *bitcoindBlock) (*processedBlock, error) {
+	txs := make([]*wire.MsgTx, len(block.Tx))
+	prevOuts := make([]map[wire.OutPoint]*wire.TxOut, len(block.Tx))
+	for i, rawTx := range block.Tx {
+		txBytes, err := hex.DecodeString(rawTx.Hex)
+		if err != nil {
+			return nil, err
+		}
+		tx := new(wire.MsgTx)
+		err = tx.Deserialize(bytes.NewReader(txBytes))
+		if err != nil {
+			return nil, err
+		}
+		if len(rawTx.Vin) != len(tx.TxIn) {
+			return nil, fmt.Errorf("tx %s: inputs don't match the decoded transaction", tx.TxHash())
+		}
+		txs[i] = tx
+
+		if rawTx.Vin[0].Coinbase != "" {
+			continue
+		}
+		prevOuts[i] = make(map[wire.OutPoint]*wire.TxOut, len(tx.TxIn))
+		for j, txIn := range tx.TxIn {
+			prevout := rawTx.Vin[j].Prevout
+			if prevout == nil {
+				return nil, fmt.Errorf("tx %s: prevout missing, getblock verbosity 3 needs Bitcoin Core 25.0 or newer", tx.TxHash())
+			}
+			pkScript, err := hex.DecodeString(prevout.ScriptPubKey.Hex)
+			if err != nil {
+				return nil, err
+			}
+			prevOuts[i][txIn.PreviousOutPoint] = wire.NewTxOut(int64(math.Round(prevout.Value*1e8)), pkScript)
+		}
+	}
+
+	return processBlock(blockHeight, hash, block.Time, txs, prevOuts)
+}
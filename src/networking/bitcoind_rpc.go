@@ -0,0 +1,106 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultBitcoindRequestTimeout is generous, blocks with prevouts of full blocks take a while to serve
+const DefaultBitcoindRequestTimeout = 5 * time.Minute
+
+// BitcoindRPC is a minimal client for the Bitcoin Core JSON-RPC
+type BitcoindRPC struct {
+	Url      string
+	User     string
+	Password string
+	// CookieFile is read on every call if no User is set, bitcoind writes a new cookie on every start
+	CookieFile string
+	HttpClient *http.Client
+	// RequestTimeout per call, zero falls back to DefaultBitcoindRequestTimeout
+	RequestTimeout time.Duration
+}
+
+// Call
+// calls method and decodes the result into out. RPC errors are returned as errors,
+// bitcoind answers them with a non 200 status but still sends the error in the body.
+func (r *BitcoindRPC) Call(ctx context.Context, method string, params []any, out any) error {
+	if params == nil {
+		params = []any{}
+	}
+	reqBody, err := json.Marshal(map[string]any{
+		"jsonrpc": "1.0",
+		"id":      "blindbitd",
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	timeout := r.RequestTimeout
+	if timeout == 0 {
+		timeout = DefaultBitcoindRequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Url, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	user, password := r.User, r.Password
+	if user == "" && r.CookieFile != "" {
+		var cookie []byte
+		cookie, err = os.ReadFile(r.CookieFile)
+		if err != nil {
+			return err
+		}
+		user, password, _ = strings.Cut(strings.TrimSpace(string(cookie)), ":")
+	}
+	if user != "" {
+		req.SetBasicAuth(user, password)
+	}
+
+	httpClient := r.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var data struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return fmt.Errorf("status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	if data.Error != nil {
+		return fmt.Errorf("rpc error %d: %s", data.Error.Code, data.Error.Message)
+	}
+
+	return json.Unmarshal(data.Result, out)
+}
@@ -0,0 +1,264 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/gcs"
+	"github.com/btcsuite/btcd/btcutil/gcs/builder"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/go-bip352"
+
+	"github.com/setavenger/blindbitd/src/utils"
+)
+
+// testBitcoindBlock is a block with a coinbase, a silent payment funded by a p2wpkh input
+// and a transaction which spends a taproot output
+type testBitcoindBlock struct {
+	hash          chainhash.Hash
+	getblock      map[string]any
+	spTx          *wire.MsgTx
+	spPrevOuts    map[wire.OutPoint]*wire.TxOut
+	spOutput      [32]byte
+	spentOutpoint wire.OutPoint
+	scanSecKey    [32]byte
+	spendPubKey   [33]byte
+}
+
+func testSecKey(b byte) ([32]byte, [33]byte) {
+	var secKey [32]byte
+	secKey[31] = b
+	_, pubKey := btcec.PrivKeyFromBytes(secKey[:])
+	return secKey, bip352.ConvertToFixedLength33(pubKey.SerializeCompressed())
+}
+
+func testTxHex(t *testing.T, tx *wire.MsgTx) string {
+	var buf bytes.Buffer
+	err := tx.Serialize(&buf)
+	if err != nil {
+		t.Fatalf("error serialising tx: %v", err)
+	}
+	return hex.EncodeToString(buf.Bytes())
+}
+
+func newTestBitcoindBlock(t *testing.T) *testBitcoindBlock {
+	block := &testBitcoindBlock{hash: chainhash.DoubleHashH([]byte("block"))}
+
+	scanSecKey, scanPubKey := testSecKey(1)
+	_, spendPubKey := testSecKey(2)
+	block.scanSecKey, block.spendPubKey = scanSecKey, spendPubKey
+	address, err := bip352.CreateAddress(scanPubKey, spendPubKey, false, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// silent payment
+	inputSecKey, inputPubKey := testSecKey(3)
+	prevHash := chainhash.DoubleHashH([]byte("prev"))
+	prevScript := append([]byte{0x00, 0x14}, bip352.Hash160(inputPubKey[:])...)
+	witness := [][]byte{make([]byte, 71), inputPubKey[:]}
+	recipient := &bip352.Recipient{SilentPaymentAddress: address, Amount: 5000}
+	err = bip352.SenderCreateOutputs([]*bip352.Recipient{recipient}, []*bip352.Vin{{
+		Txid:         bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(prevHash[:])),
+		Vout:         0,
+		Amount:       10_000,
+		PublicKey:    &inputPubKey,
+		SecretKey:    &inputSecKey,
+		Witness:      witness,
+		ScriptPubKey: prevScript,
+	}}, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.spOutput = recipient.Output
+
+	spTx := wire.NewMsgTx(2)
+	spTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: prevHash, Index: 0}, nil, witness))
+	spTx.AddTxOut(wire.NewTxOut(5000, append([]byte{0x51, 0x20}, recipient.Output[:]...)))
+	spTx.AddTxOut(wire.NewTxOut(4000, prevScript))
+	block.spTx = spTx
+	block.spPrevOuts = map[wire.OutPoint]*wire.TxOut{spTx.TxIn[0].PreviousOutPoint: wire.NewTxOut(10_000, prevScript)}
+
+	// key path spend of a taproot output
+	_, taprootPubKey := testSecKey(4)
+	block.spentOutpoint = wire.OutPoint{Hash: chainhash.DoubleHashH([]byte("taproot")), Index: 1}
+	spendTx := wire.NewMsgTx(2)
+	spendTx.AddTxIn(wire.NewTxIn(&block.spentOutpoint, nil, [][]byte{make([]byte, 64)}))
+	spendTx.AddTxOut(wire.NewTxOut(19_000, prevScript))
+
+	coinbase := wire.NewMsgTx(2)
+	coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex}, []byte{0x01, 0x64}, nil))
+	coinbase.AddTxOut(wire.NewTxOut(50_000, prevScript))
+
+	prevout := func(value float64, script []byte) map[string]any {
+		return map[string]any{"value": value, "scriptPubKey": map[string]any{"hex": hex.EncodeToString(script)}}
+	}
+	block.getblock = map[string]any{
+		"hash": block.hash.String(),
+		"time": 1700000000,
+		"tx": []map[string]any{
+			{"hex": testTxHex(t, coinbase), "vin": []map[string]any{{"coinbase": "0164"}}},
+			{"hex": testTxHex(t, spTx), "vin": []map[string]any{{"prevout": prevout(0.0001, prevScript)}}},
+			{"hex": testTxHex(t, spendTx), "vin": []map[string]any{{"prevout": prevout(0.0002, append([]byte{0x51, 0x20}, taprootPubKey[1:]...))}}},
+		},
+	}
+	return block
+}
+
+func newTestBitcoind(t *testing.T, block *testBitcoindBlock) (*httptest.Server, *int) {
+	getblockCalls := new(int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		var result any
+		switch req.Method {
+		case "getblockcount":
+			result = 100
+		case "getblockhash":
+			result = block.hash.String()
+		case "getblock":
+			if req.Params[0] != block.hash.String() || req.Params[1] != float64(3) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			*getblockCalls++
+			result = block.getblock
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": -32601, "message": "Method not found"}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result, "error": nil})
+	}))
+	return server, getblockCalls
+}
+
+func TestClientBitcoind(t *testing.T) {
+	block := newTestBitcoindBlock(t)
+	server, getblockCalls := newTestBitcoind(t, block)
+	defer server.Close()
+
+	client := NewClientBitcoind(&BitcoindRPC{Url: server.URL})
+	ctx := context.Background()
+
+	tip, err := client.GetChainTip(ctx)
+	if err != nil || tip != 100 {
+		t.Fatalf("wrong chain tip %d: %v", tip, err)
+	}
+
+	tweaks, err := client.GetTweaks(ctx, 100, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedTweak, err := utils.ComputeTweak(block.spTx, block.spPrevOuts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tweaks) != 1 || tweaks[0] != expectedTweak {
+		t.Fatalf("wrong tweaks %x, expected %x", tweaks, expectedTweak)
+	}
+	found, err := bip352.ReceiverScanTransaction(block.scanSecKey, block.spendPubKey, nil, [][32]byte{block.spOutput}, tweaks[0], nil)
+	if err != nil || len(found) != 1 {
+		t.Errorf("silent payment not found with the computed tweak: %v", err)
+	}
+
+	tweaks, err = client.GetTweakIndex(ctx, 100, 6000)
+	if err != nil || len(tweaks) != 0 {
+		t.Errorf("dust limit not applied: %x %v", tweaks, err)
+	}
+
+	utxos, err := client.GetUTXOs(ctx, 100)
+	if err != nil || len(utxos) != 1 {
+		t.Fatalf("wrong utxos %v: %v", utxos, err)
+	}
+	spTxHash := block.spTx.TxHash()
+	if utxos[0].Txid != bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(spTxHash[:])) || utxos[0].Vout != 0 || utxos[0].Amount != 5000 {
+		t.Errorf("wrong utxo %+v", utxos[0])
+	}
+
+	filter, err := client.GetFilter(ctx, 100, NewUTXOFilterType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter.BlockHash != utxos[0].BlockHash {
+		t.Errorf("filter block hash %x differs from utxo block hash %x", filter.BlockHash, utxos[0].BlockHash)
+	}
+	gcsFilter, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM, filter.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := builder.DeriveKey(&block.hash)
+	if match, _ := gcsFilter.Match(key, block.spOutput[:]); !match {
+		t.Errorf("new utxos filter does not match the output")
+	}
+
+	spentIndex, err := client.GetSpentOutpointsIndex(ctx, 100)
+	if err != nil || len(spentIndex.Data) != 1 || spentIndex.Data[0] != spentOutpointHash(block.spentOutpoint, block.hash) {
+		t.Errorf("wrong spent index %x: %v", spentIndex.Data, err)
+	}
+	spentFilter, err := client.GetFilter(ctx, 100, SpentOutpointsFilterType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcsFilter, err = gcs.FromNBytes(builder.DefaultP, builder.DefaultM, spentFilter.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match, _ := gcsFilter.Match(key, spentIndex.Data[0][:]); !match {
+		t.Errorf("spent filter does not match the spent outpoint")
+	}
+
+	if *getblockCalls != 1 {
+		t.Errorf("block was fetched %d times", *getblockCalls)
+	}
+
+	_, err = client.GetFilter(ctx, 100, "unknown")
+	if err == nil {
+		t.Errorf("unknown filter type accepted")
+	}
+}
+
+func TestClientBitcoindMissingPrevouts(t *testing.T) {
+	block := newTestBitcoindBlock(t)
+	// bitcoind before 25.0 ignores verbosity 3 and answers like verbosity 2
+	for _, tx := range block.getblock["tx"].([]map[string]any)[1:] {
+		tx["vin"] = []map[string]any{{"txid": "00"}}
+	}
+	server, _ := newTestBitcoind(t, block)
+	defer server.Close()
+
+	_, err := NewClientBitcoind(&BitcoindRPC{Url: server.URL}).GetTweaks(context.Background(), 100, 0)
+	if err == nil {
+		t.Errorf("block without prevouts accepted")
+	}
+}
+
+func TestBitcoindRPCTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a node which never answers
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	rpc := &BitcoindRPC{Url: server.URL, HttpClient: &http.Client{}, RequestTimeout: 50 * time.Millisecond}
+	var tip uint64
+	err := rpc.Call(context.Background(), "getblockcount", nil, &tip)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a timeout, got %v", err)
+	}
+}
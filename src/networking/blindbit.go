@@ -1,11 +1,11 @@
 package networking
 
 import (
+	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"time"
 
 	"github.com/setavenger/blindbitd/src/logging"
 	"github.com/setavenger/blindbitd/src/utils"
@@ -13,7 +13,7 @@ import (
 )
 
 /*
-Most of this will probably be removed in favour of binary encodings (proto buffs)
+The JSON API of the indexer, see ClientBlindBitGRPC for the binary (protobuf) version
 */
 
 type FilterType string
@@ -23,8 +23,15 @@ const (
 	NewUTXOFilterType        FilterType = "new-utxos"
 )
 
+// ClientBlindBit implements Indexer and FeeEstimator over the JSON/HTTP API
 type ClientBlindBit struct {
-	BaseUrl string
+	BaseUrl    string
+	HttpClient *http.Client // http.DefaultClient if nil
+
+	// zero values fall back to DefaultRequestTimeout, DefaultRetries and DefaultRetryBaseDelay
+	RequestTimeout time.Duration // per attempt
+	Retries        int
+	RetryBaseDelay time.Duration // doubled after every failed attempt
 }
 
 type Filter struct {
@@ -50,33 +57,25 @@ type SpentOutpointsIndex struct {
 	Data      [][8]byte `json:"data"`
 }
 
-func (c ClientBlindBit) GetTweaks(blockHeight, dustLimit uint64) ([][33]byte, error) {
-	// todo add support for the /tweak-index/ endpoint
-	url := fmt.Sprintf("%s/tweaks/%d", c.BaseUrl, blockHeight)
-	if dustLimit > 0 {
-		url = fmt.Sprintf("%s?dustLimit=%d", url, dustLimit)
-	}
+// GetTweaks
+// fetches the cut-through tweaks of a block. Transactions whose taproot outputs are all spent are left out.
+func (c ClientBlindBit) GetTweaks(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	return c.getTweaks(ctx, "tweaks", blockHeight, dustLimit)
+}
 
-	// HTTP GET request
-	resp, err := http.Get(url)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
+// GetTweakIndex fetches the tweaks of all eligible transactions of a block, regardless of whether they are spent
+func (c ClientBlindBit) GetTweakIndex(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	return c.getTweaks(ctx, "tweak-index", blockHeight, dustLimit)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
+func (c ClientBlindBit) getTweaks(ctx context.Context, endpoint string, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	url := fmt.Sprintf("%s/%s/%d", c.BaseUrl, endpoint, blockHeight)
+	if dustLimit > 0 {
+		url = fmt.Sprintf("%s?dustLimit=%d", url, dustLimit)
 	}
 
-	// Unmarshal JSON data into a []string
 	var data []string
-	err = json.Unmarshal(body, &data)
+	err := c.getJSON(ctx, url, &data)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -104,29 +103,13 @@ func (c ClientBlindBit) GetTweaks(blockHeight, dustLimit uint64) ([][33]byte, er
 	return bytesData, nil
 }
 
-func (c ClientBlindBit) GetChainTip() (uint64, error) {
+func (c ClientBlindBit) GetChainTip(ctx context.Context) (uint64, error) {
 	url := fmt.Sprintf("%s/block-height", c.BaseUrl)
 
-	// HTTP GET request
-	resp, err := http.Get(url)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return 0, err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return 0, err
-	}
-
 	var data struct {
 		BlockHeight uint64 `json:"block_height"`
 	}
-	err = json.Unmarshal(body, &data)
+	err := c.getJSON(ctx, url, &data)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return 0, err
@@ -135,32 +118,16 @@ func (c ClientBlindBit) GetChainTip() (uint64, error) {
 	return data.BlockHeight, err
 }
 
-func (c ClientBlindBit) GetFilter(blockHeight uint64, filterType FilterType) (*Filter, error) {
+func (c ClientBlindBit) GetFilter(ctx context.Context, blockHeight uint64, filterType FilterType) (*Filter, error) {
 	url := fmt.Sprintf("%s/filter/%s/%d", c.BaseUrl, filterType, blockHeight)
 
-	// HTTP GET request
-	resp, err := http.Get(url)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
-
 	var data struct {
 		FilterType  uint8  `json:"filter_type"`
 		BlockHeight uint64 `json:"block_height"`
 		BlockHash   string `json:"block_hash"`
 		Data        string `json:"data"`
 	}
-	err = json.Unmarshal(body, &data)
+	err := c.getJSON(ctx, url, &data)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -187,26 +154,9 @@ func (c ClientBlindBit) GetFilter(blockHeight uint64, filterType FilterType) (*F
 	return filter, err
 }
 
-func (c ClientBlindBit) GetUTXOs(blockHeight uint64) ([]*UTXOServed, error) {
+func (c ClientBlindBit) GetUTXOs(ctx context.Context, blockHeight uint64) ([]*UTXOServed, error) {
 	url := fmt.Sprintf("%s/utxos/%d", c.BaseUrl, blockHeight)
 
-	// HTTP GET request
-	resp, err := http.Get(url)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
-
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
-	}
-
 	var dataSlice []struct {
 		Txid         string `json:"txid"`
 		Vout         uint32 `json:"vout"`
@@ -218,7 +168,7 @@ func (c ClientBlindBit) GetUTXOs(blockHeight uint64) ([]*UTXOServed, error) {
 		Spent        bool   `json:"spent"`
 	}
 
-	err = json.Unmarshal(body, &dataSlice)
+	err := c.getJSON(ctx, url, &dataSlice)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return nil, err
@@ -262,33 +212,15 @@ func (c ClientBlindBit) GetUTXOs(blockHeight uint64) ([]*UTXOServed, error) {
 	return utxos, err
 }
 
-func (c ClientBlindBit) GetSpentOutpointsIndex(blockHeight uint64) (SpentOutpointsIndex, error) {
+func (c ClientBlindBit) GetSpentOutpointsIndex(ctx context.Context, blockHeight uint64) (SpentOutpointsIndex, error) {
 	url := fmt.Sprintf("%s/spent-index/%d", c.BaseUrl, blockHeight)
 
-	// HTTP GET request
-	resp, err := http.Get(url)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return SpentOutpointsIndex{}, err
-	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
-
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return SpentOutpointsIndex{}, err
-	}
-
 	var respData struct {
 		BlockHash string   `json:"block_hash"`
 		Data      []string `json:"data"`
 	}
 
-	// Unmarshal JSON data into a []string
-	err = json.Unmarshal(body, &respData)
+	err := c.getJSON(ctx, url, &respData)
 	if err != nil {
 		logging.ErrorLogger.Println(err)
 		return SpentOutpointsIndex{}, err
@@ -326,3 +258,31 @@ func (c ClientBlindBit) GetSpentOutpointsIndex(blockHeight uint64) (SpentOutpoin
 
 	return output, nil
 }
+
+// GetFeeEstimates
+// fetches fee estimates from the indexer. The response follows the esplora format
+// where the key is the confirmation target in blocks and the value is the fee rate in sats/vByte.
+// This endpoint is optional and not every indexing server provides it.
+func (c ClientBlindBit) GetFeeEstimates(ctx context.Context) (map[uint32]float64, error) {
+	url := fmt.Sprintf("%s/fee-estimates", c.BaseUrl)
+
+	var data map[string]float64
+	err := c.getJSON(ctx, url, &data)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	estimates := make(map[uint32]float64, len(data))
+	for target, feeRate := range data {
+		var confTarget uint32
+		_, err = fmt.Sscanf(target, "%d", &confTarget)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		estimates[confTarget] = feeRate
+	}
+
+	return estimates, nil
+}
@@ -0,0 +1,199 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+	"github.com/setavenger/go-bip352"
+)
+
+// ClientBlindBitGRPC
+// talks to the gRPC service of BlindBit Oracle. The data is served as raw bytes, so nothing has to be hex decoded.
+// Experimental: the service definition in pb/oracle.proto is not yet generated from the BlindBit-Protos submodule.
+type ClientBlindBitGRPC struct {
+	conn    *grpc.ClientConn
+	client  pb.OracleServiceClient
+	Timeout time.Duration // per request
+}
+
+// NewClientBlindBitGRPC
+// creates a client for the oracle at address (host:port). The connection is established lazily with the first request.
+// opts are appended to the default options, e.g. a dialer through Tor.
+func NewClientBlindBitGRPC(address string, opts ...grpc.DialOption) (*ClientBlindBitGRPC, error) {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return NewClientBlindBitGRPCFromConn(conn), nil
+}
+
+// NewClientBlindBitGRPCFromConn creates a client on an existing connection
+func NewClientBlindBitGRPCFromConn(conn *grpc.ClientConn) *ClientBlindBitGRPC {
+	return &ClientBlindBitGRPC{
+		conn:    conn,
+		client:  pb.NewOracleServiceClient(conn),
+		Timeout: 30 * time.Second,
+	}
+}
+
+func (c *ClientBlindBitGRPC) Close() error {
+	return c.conn.Close()
+}
+
+func (c *ClientBlindBitGRPC) GetChainTip(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	resp, err := c.client.GetBestBlockHeight(ctx, &pb.OracleEmpty{})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return 0, err
+	}
+	return resp.BlockHeight, nil
+}
+
+func (c *ClientBlindBitGRPC) GetTweaks(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	resp, err := c.client.GetTweakArray(ctx, &pb.BlockHeightRequest{BlockHeight: blockHeight, DustLimit: dustLimit})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return convertTweakArray(resp)
+}
+
+func (c *ClientBlindBitGRPC) GetTweakIndex(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	resp, err := c.client.GetTweakIndexArray(ctx, &pb.BlockHeightRequest{BlockHeight: blockHeight, DustLimit: dustLimit})
+	if status.Code(err) == codes.Unimplemented {
+		// older oracles don't serve the tweak index
+		return nil, fmt.Errorf("%w: %v", src.ErrIndexerNotFound, err)
+	}
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return convertTweakArray(resp)
+}
+
+func convertTweakArray(resp *pb.TweakArray) ([][33]byte, error) {
+	tweaks := make([][33]byte, len(resp.Tweaks))
+	for i, tweak := range resp.Tweaks {
+		if len(tweak) != 33 {
+			err := fmt.Errorf("invalid tweak length: %d", len(tweak))
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		tweaks[i] = bip352.ConvertToFixedLength33(tweak)
+	}
+	return tweaks, nil
+}
+
+func (c *ClientBlindBitGRPC) GetFilter(ctx context.Context, blockHeight uint64, filterType FilterType) (*Filter, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	var pbFilterType pb.FilterType
+	switch filterType {
+	case SpentOutpointsFilterType:
+		pbFilterType = pb.FilterType_FILTER_TYPE_SPENT
+	case NewUTXOFilterType:
+		pbFilterType = pb.FilterType_FILTER_TYPE_NEW_UTXOS
+	default:
+		return nil, fmt.Errorf("unknown filter type %s", filterType)
+	}
+
+	resp, err := c.client.GetFilter(ctx, &pb.GetFilterRequest{BlockHeight: blockHeight, FilterType: pbFilterType})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	if len(resp.BlockHash) != 32 {
+		err = fmt.Errorf("invalid block hash length: %d", len(resp.BlockHash))
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	return &Filter{
+		FilterType:  uint8(resp.FilterType),
+		BlockHeight: resp.BlockHeight,
+		BlockHash:   bip352.ConvertToFixedLength32(resp.BlockHash),
+		Data:        resp.Data,
+	}, nil
+}
+
+func (c *ClientBlindBitGRPC) GetUTXOs(ctx context.Context, blockHeight uint64) ([]*UTXOServed, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	resp, err := c.client.GetUTXOArray(ctx, &pb.BlockHeightRequest{BlockHeight: blockHeight})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	utxos := make([]*UTXOServed, len(resp.Utxos))
+	for i, utxo := range resp.Utxos {
+		if len(utxo.Txid) != 32 || len(utxo.BlockHash) != 32 || len(utxo.ScriptPubKey) != 34 {
+			err = fmt.Errorf("invalid utxo at index %d", i)
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		utxos[i] = &UTXOServed{
+			Txid:         bip352.ConvertToFixedLength32(utxo.Txid),
+			Vout:         utxo.Vout,
+			Amount:       utxo.Value,
+			ScriptPubKey: utils.ConvertToFixedLength34(utxo.ScriptPubKey),
+			BlockHeight:  utxo.BlockHeight,
+			BlockHash:    bip352.ConvertToFixedLength32(utxo.BlockHash),
+			Timestamp:    utxo.Timestamp,
+			Spent:        utxo.Spent,
+		}
+	}
+	return utxos, nil
+}
+
+func (c *ClientBlindBitGRPC) GetSpentOutpointsIndex(ctx context.Context, blockHeight uint64) (SpentOutpointsIndex, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+
+	resp, err := c.client.GetSpentOutpointsIndex(ctx, &pb.BlockHeightRequest{BlockHeight: blockHeight})
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return SpentOutpointsIndex{}, err
+	}
+	if len(resp.BlockHash) != 32 {
+		err = fmt.Errorf("invalid block hash length: %d", len(resp.BlockHash))
+		logging.ErrorLogger.Println(err)
+		return SpentOutpointsIndex{}, err
+	}
+
+	index := SpentOutpointsIndex{BlockHash: bip352.ConvertToFixedLength32(resp.BlockHash)}
+	for _, hash := range resp.Data {
+		if len(hash) != 8 {
+			err = fmt.Errorf("invalid spent outpoint hash length: %d", len(hash))
+			logging.ErrorLogger.Println(err)
+			return SpentOutpointsIndex{}, err
+		}
+		var shortHash [8]byte
+		copy(shortHash[:], hash)
+		index.Data = append(index.Data, shortHash)
+	}
+	return index, nil
+}
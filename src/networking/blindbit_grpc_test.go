@@ -0,0 +1,139 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/setavenger/blindbitd/src"
+)
+
+var (
+	_ Indexer      = (*ClientBlindBit)(nil)
+	_ FeeEstimator = (*ClientBlindBit)(nil)
+	_ Indexer      = (*ClientBlindBitGRPC)(nil)
+)
+
+// oracleStandIn serves fixed data for block 100
+type oracleStandIn struct {
+	pb.UnimplementedOracleServiceServer
+}
+
+func (o *oracleStandIn) GetBestBlockHeight(context.Context, *pb.OracleEmpty) (*pb.BlockHeightResponse, error) {
+	return &pb.BlockHeightResponse{BlockHeight: 100}, nil
+}
+
+func (o *oracleStandIn) GetTweakArray(_ context.Context, in *pb.BlockHeightRequest) (*pb.TweakArray, error) {
+	tweaks := [][]byte{bytes.Repeat([]byte{0x02}, 33)}
+	if in.DustLimit == 0 {
+		tweaks = append(tweaks, bytes.Repeat([]byte{0x03}, 33))
+	}
+	return &pb.TweakArray{Tweaks: tweaks}, nil
+}
+
+func (o *oracleStandIn) GetFilter(_ context.Context, in *pb.GetFilterRequest) (*pb.FilterResponse, error) {
+	return &pb.FilterResponse{
+		FilterType:  in.FilterType,
+		BlockHeight: in.BlockHeight,
+		BlockHash:   bytes.Repeat([]byte{0xaa}, 32),
+		Data:        []byte{0x01, 0x02},
+	}, nil
+}
+
+func (o *oracleStandIn) GetUTXOArray(_ context.Context, in *pb.BlockHeightRequest) (*pb.UTXOArrayResponse, error) {
+	return &pb.UTXOArrayResponse{Utxos: []*pb.ServedUTXO{{
+		Txid:         bytes.Repeat([]byte{0x11}, 32),
+		Vout:         1,
+		Value:        50_000,
+		ScriptPubKey: append([]byte{0x51, 0x20}, bytes.Repeat([]byte{0x22}, 32)...),
+		BlockHeight:  in.BlockHeight,
+		BlockHash:    bytes.Repeat([]byte{0xaa}, 32),
+	}}}, nil
+}
+
+func (o *oracleStandIn) GetSpentOutpointsIndex(context.Context, *pb.BlockHeightRequest) (*pb.SpentOutpointsIndexResponse, error) {
+	return &pb.SpentOutpointsIndexResponse{
+		BlockHash: bytes.Repeat([]byte{0xaa}, 32),
+		Data:      [][]byte{bytes.Repeat([]byte{0x33}, 8), {0x01}},
+	}, nil
+}
+
+func newTestClientGRPC(t *testing.T) *ClientBlindBitGRPC {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterOracleServiceServer(server, &oracleStandIn{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///oracle",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("error creating client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return NewClientBlindBitGRPCFromConn(conn)
+}
+
+func TestClientBlindBitGRPC(t *testing.T) {
+	client := newTestClientGRPC(t)
+	ctx := context.Background()
+
+	tip, err := client.GetChainTip(ctx)
+	if err != nil || tip != 100 {
+		t.Errorf("wrong chain tip %d: %v", tip, err)
+	}
+
+	tweaks, err := client.GetTweaks(ctx, 100, 1000)
+	if err != nil || len(tweaks) != 1 || tweaks[0][0] != 0x02 {
+		t.Errorf("wrong tweaks %x: %v", tweaks, err)
+	}
+	tweaks, err = client.GetTweaks(ctx, 100, 0)
+	if err != nil || len(tweaks) != 2 {
+		t.Errorf("dust limit not passed on, got %d tweaks: %v", len(tweaks), err)
+	}
+
+	filter, err := client.GetFilter(ctx, 100, NewUTXOFilterType)
+	if err != nil {
+		t.Errorf("error getting filter: %v", err)
+		return
+	}
+	if filter.BlockHeight != 100 || filter.FilterType != uint8(pb.FilterType_FILTER_TYPE_NEW_UTXOS) || filter.BlockHash[0] != 0xaa || !bytes.Equal(filter.Data, []byte{0x01, 0x02}) {
+		t.Errorf("wrong filter: %+v", filter)
+	}
+
+	utxos, err := client.GetUTXOs(ctx, 100)
+	if err != nil || len(utxos) != 1 {
+		t.Errorf("wrong utxos: %v", err)
+		return
+	}
+	if utxos[0].Vout != 1 || utxos[0].Amount != 50_000 || utxos[0].ScriptPubKey[2] != 0x22 || utxos[0].Txid[0] != 0x11 {
+		t.Errorf("wrong utxo: %+v", utxos[0])
+	}
+
+	// the stand-in does not serve the tweak index
+	_, err = client.GetTweakIndex(ctx, 100, 0)
+	if !errors.Is(err, src.ErrIndexerNotFound) {
+		t.Errorf("missing tweak index not mapped: %v", err)
+	}
+
+	// the second hash of the stand-in has an invalid length
+	_, err = client.GetSpentOutpointsIndex(ctx, 100)
+	if err == nil {
+		t.Errorf("invalid spent outpoint hash was accepted")
+	}
+}
@@ -0,0 +1,130 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+func newTestClientBlindBit(url string) *ClientBlindBit {
+	return &ClientBlindBit{BaseUrl: url, HttpClient: NewHttpClient(""), RetryBaseDelay: time.Millisecond}
+}
+
+func TestClientBlindBitRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("<html>bad gateway</html>"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"block_height":840000}`))
+	}))
+	defer server.Close()
+
+	tip, err := newTestClientBlindBit(server.URL).GetChainTip(context.Background())
+	if err != nil || tip != 840000 {
+		t.Errorf("wrong chain tip %d: %v", tip, err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestClientBlindBitStatusErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.URL.Path {
+		case "/tweaks/1":
+			w.WriteHeader(http.StatusNotFound)
+		case "/utxos/1":
+			_, _ = w.Write([]byte("<html>not json</html>"))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClientBlindBit(server.URL)
+
+	_, err := client.GetTweaks(context.Background(), 1, 0)
+	var statusErr *HTTPStatusError
+	if !errors.Is(err, src.ErrIndexerNotFound) || !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("404 not mapped: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("404 was retried")
+	}
+
+	_, err = client.GetUTXOs(context.Background(), 1)
+	if !errors.Is(err, src.ErrIndexerBadResponse) {
+		t.Errorf("invalid body not mapped: %v", err)
+	}
+
+	calls.Store(0)
+	_, err = client.GetChainTip(context.Background())
+	if !errors.Is(err, src.ErrIndexerUnavailable) {
+		t.Errorf("503 not mapped: %v", err)
+	}
+	if calls.Load() != DefaultRetries+1 {
+		t.Errorf("expected %d attempts, got %d", DefaultRetries+1, calls.Load())
+	}
+}
+
+func TestClientBlindBitCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := newTestClientBlindBit(server.URL).GetChainTip(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancellation, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("cancellation took %s", time.Since(start))
+	}
+}
+
+func TestClientBlindBitTweakEndpoints(t *testing.T) {
+	tweak := strings.Repeat("02", 33)
+	spentTweak := strings.Repeat("03", 33)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("dustLimit") != "1000" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/tweaks/100":
+			_, _ = w.Write([]byte(`["` + tweak + `"]`))
+		case "/tweak-index/100":
+			_, _ = w.Write([]byte(`["` + tweak + `","` + spentTweak + `"]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClientBlindBit(server.URL)
+
+	cutThrough, err := client.GetTweaks(context.Background(), 100, 1000)
+	if err != nil || len(cutThrough) != 1 || cutThrough[0][0] != 0x02 {
+		t.Errorf("wrong cut-through tweaks %x: %v", cutThrough, err)
+	}
+	full, err := client.GetTweakIndex(context.Background(), 100, 1000)
+	if err != nil || len(full) != 2 || full[1][0] != 0x03 {
+		t.Errorf("wrong tweak index %x: %v", full, err)
+	}
+}
@@ -0,0 +1,208 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// Broadcaster publishes a raw transaction to the network and returns the txid
+type Broadcaster interface {
+	Name() string
+	Broadcast(ctx context.Context, rawTx []byte) (string, error)
+}
+
+// Broadcasters are tried in order until one accepts the transaction
+type Broadcasters []Broadcaster
+
+// Broadcast
+// tries every broadcaster in order and returns the txid of the first one which accepted the transaction.
+// If all fail the errors of every backend are returned.
+func (b Broadcasters) Broadcast(ctx context.Context, rawTx []byte) (string, error) {
+	if len(b) == 0 {
+		return "", src.ErrNoBroadcastBackend
+	}
+
+	var errs []error
+	for _, broadcaster := range b {
+		txid, err := broadcaster.Broadcast(ctx, rawTx)
+		if err != nil {
+			logging.WarningLogger.Printf("broadcast via %s failed: %s\n", broadcaster.Name(), err)
+			errs = append(errs, fmt.Errorf("%s: %w", broadcaster.Name(), err))
+			continue
+		}
+		logging.InfoLogger.Printf("broadcast %s via %s\n", txid, broadcaster.Name())
+		return txid, nil
+	}
+
+	return "", errors.Join(errs...)
+}
+
+// CreateBroadcasters
+// creates the broadcasters for the configured backends. Electrum is skipped if it is not used.
+func CreateBroadcasters(backends []src.BroadcastBackend, electrumSupervisor *ElectrumSupervisor) Broadcasters {
+	var broadcasters Broadcasters
+	for _, backend := range backends {
+		switch backend.Type {
+		case src.BroadcastBackendElectrum:
+			if electrumSupervisor == nil {
+				continue
+			}
+			broadcasters = append(broadcasters, &ElectrumBroadcaster{Electrum: electrumSupervisor})
+		case src.BroadcastBackendBitcoind:
+			broadcasters = append(broadcasters, &BitcoindBroadcaster{
+				Url:        backend.Url,
+				User:       backend.User,
+				Password:   backend.Password,
+				HttpClient: NewHttpClient(backend.TorProxyHost),
+			})
+		case src.BroadcastBackendEsplora:
+			broadcasters = append(broadcasters, &EsploraBroadcaster{
+				BaseUrl:    strings.TrimSuffix(backend.Url, "/"),
+				HttpClient: NewHttpClient(backend.TorProxyHost),
+			})
+		case src.BroadcastBackendIndexer:
+			broadcasters = append(broadcasters, &IndexerBroadcaster{
+				BaseUrl:    strings.TrimSuffix(src.BlindBitServerAddress, "/"),
+				HttpClient: NewHttpClient(backend.TorProxyHost),
+			})
+		}
+	}
+	return broadcasters
+}
+
+// NewHttpClient
+// returns a client which connects through the SOCKS5 proxy at torProxyHost. No proxy is used if torProxyHost is empty.
+// Host names are resolved by the proxy so that onion addresses work.
+func NewHttpClient(torProxyHost string) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if torProxyHost != "" {
+		transport.Proxy = http.ProxyURL(&url.URL{Scheme: "socks5", Host: torProxyHost})
+	}
+	return &http.Client{Transport: transport, Timeout: 30 * time.Second}
+}
+
+type ElectrumBroadcaster struct {
+	Electrum *ElectrumSupervisor
+}
+
+func (b *ElectrumBroadcaster) Name() string {
+	return src.BroadcastBackendElectrum
+}
+
+func (b *ElectrumBroadcaster) Broadcast(ctx context.Context, rawTx []byte) (string, error) {
+	client, err := b.Electrum.Client()
+	if err != nil {
+		return "", err
+	}
+	return client.BroadcastTransaction(ctx, hex.EncodeToString(rawTx))
+}
+
+// BitcoindBroadcaster uses `sendrawtransaction` of the Bitcoin Core JSON-RPC
+type BitcoindBroadcaster struct {
+	Url        string
+	User       string
+	Password   string
+	HttpClient *http.Client
+}
+
+func (b *BitcoindBroadcaster) Name() string {
+	return src.BroadcastBackendBitcoind
+}
+
+func (b *BitcoindBroadcaster) Broadcast(ctx context.Context, rawTx []byte) (string, error) {
+	rpc := BitcoindRPC{Url: b.Url, User: b.User, Password: b.Password, HttpClient: b.HttpClient}
+	var txid string
+	err := rpc.Call(ctx, "sendrawtransaction", []any{hex.EncodeToString(rawTx)}, &txid)
+	if err != nil {
+		return "", err
+	}
+	return txid, nil
+}
+
+// EsploraBroadcaster uses `POST /tx` of an esplora API (e.g. mempool.space/api)
+type EsploraBroadcaster struct {
+	BaseUrl    string
+	HttpClient *http.Client
+}
+
+func (b *EsploraBroadcaster) Name() string {
+	return src.BroadcastBackendEsplora
+}
+
+func (b *EsploraBroadcaster) Broadcast(ctx context.Context, rawTx []byte) (string, error) {
+	body, err := postBroadcast(ctx, b.HttpClient, b.BaseUrl+"/tx", "text/plain", []byte(hex.EncodeToString(rawTx)))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// IndexerBroadcaster uses the `POST /forward-tx` endpoint of the BlindBit indexer. Not every indexer exposes it.
+type IndexerBroadcaster struct {
+	BaseUrl    string
+	HttpClient *http.Client
+}
+
+func (b *IndexerBroadcaster) Name() string {
+	return src.BroadcastBackendIndexer
+}
+
+func (b *IndexerBroadcaster) Broadcast(ctx context.Context, rawTx []byte) (string, error) {
+	// the indexer does not return the txid so we compute it before sending anything
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(rawTx))
+	if err != nil {
+		return "", err
+	}
+
+	reqBody, err := json.Marshal(map[string]string{"data": hex.EncodeToString(rawTx)})
+	if err != nil {
+		return "", err
+	}
+	_, err = postBroadcast(ctx, b.HttpClient, b.BaseUrl+"/forward-tx", "application/json", reqBody)
+	if err != nil {
+		return "", err
+	}
+
+	return tx.TxHash().String(), nil
+}
+
+// postBroadcast sends reqBody and returns the response body, any status other than 200 is an error
+func postBroadcast(ctx context.Context, client *http.Client, url, contentType string, reqBody []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
@@ -0,0 +1,128 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+func init() {
+	logging.LoadLoggersMock()
+}
+
+func testRawTx(t *testing.T) ([]byte, string) {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(10_000, append([]byte{0x51, 0x20}, make([]byte, 32)...)))
+
+	var buf bytes.Buffer
+	err := tx.Serialize(&buf)
+	if err != nil {
+		t.Fatalf("error serialising tx: %v", err)
+	}
+	return buf.Bytes(), tx.TxHash().String()
+}
+
+func TestBitcoindBroadcaster(t *testing.T) {
+	rawTx, txid := testRawTx(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Method string   `json:"method"`
+			Params []string `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "sendrawtransaction" || req.Params[0] != hex.EncodeToString(rawTx) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"result":null,"error":{"code":-22,"message":"TX decode failed"},"id":"blindbitd"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":"` + txid + `","error":null,"id":"blindbitd"}`))
+	}))
+	defer server.Close()
+
+	broadcaster := &BitcoindBroadcaster{Url: server.URL, User: "user", Password: "pass", HttpClient: NewHttpClient("")}
+	result, err := broadcaster.Broadcast(context.Background(), rawTx)
+	if err != nil {
+		t.Errorf("error broadcasting: %v", err)
+		return
+	}
+	if result != txid {
+		t.Errorf("wrong txid: %s", result)
+	}
+
+	_, err = broadcaster.Broadcast(context.Background(), []byte{0x00})
+	if err == nil || !strings.Contains(err.Error(), "TX decode failed") {
+		t.Errorf("rpc error not returned: %v", err)
+	}
+}
+
+func TestBroadcastersFallback(t *testing.T) {
+	rawTx, txid := testRawTx(t)
+
+	var calls []string
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "failing")
+		http.Error(w, "sendrawtransaction RPC error", http.StatusBadRequest)
+	}))
+	defer failing.Close()
+
+	esplora := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "esplora")
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/tx" || string(body) != hex.EncodeToString(rawTx) {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(txid))
+	}))
+	defer esplora.Close()
+
+	indexer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "indexer")
+	}))
+	defer indexer.Close()
+
+	broadcasters := Broadcasters{
+		&IndexerBroadcaster{BaseUrl: failing.URL, HttpClient: NewHttpClient("")},
+		&EsploraBroadcaster{BaseUrl: esplora.URL, HttpClient: NewHttpClient("")},
+		&IndexerBroadcaster{BaseUrl: indexer.URL, HttpClient: NewHttpClient("")},
+	}
+
+	result, err := broadcasters.Broadcast(context.Background(), rawTx)
+	if err != nil {
+		t.Errorf("error broadcasting: %v", err)
+		return
+	}
+	if result != txid {
+		t.Errorf("wrong txid: %s", result)
+	}
+	if strings.Join(calls, ",") != "failing,esplora" {
+		t.Errorf("backends were not tried in order: %v", calls)
+	}
+
+	// the indexer does not return the txid, it is computed locally
+	result, err = broadcasters[2:].Broadcast(context.Background(), rawTx)
+	if err != nil || result != txid {
+		t.Errorf("indexer broadcast failed: %s %v", result, err)
+	}
+
+	_, err = broadcasters[:1].Broadcast(context.Background(), rawTx)
+	if err == nil || !strings.Contains(err.Error(), "RPC error") {
+		t.Errorf("backend error not returned: %v", err)
+	}
+}
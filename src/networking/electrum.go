@@ -1,22 +1,396 @@
 package networking
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
 	"github.com/setavenger/blindbitd/src"
 	"github.com/setavenger/blindbitd/src/logging"
 	"github.com/setavenger/go-electrum/electrum"
-	"time"
 )
 
-func CreateElectrumClient() (*electrum.Client, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+const (
+	electrumDialTimeout = 15 * time.Second
+	// DefaultElectrumPingTimeout a connection is considered lost if a ping is not answered within this duration
+	DefaultElectrumPingTimeout     = 10 * time.Second
+	DefaultElectrumReconnectDelay  = time.Second
+	DefaultElectrumReconnectMaxGap = 2 * time.Minute
+)
+
+type ElectrumState int
+
+const (
+	ElectrumDisconnected ElectrumState = iota
+	ElectrumConnecting
+	ElectrumConnected
+)
+
+// ElectrumStatus is a snapshot of the connection managed by an ElectrumSupervisor
+type ElectrumStatus struct {
+	State          ElectrumState
+	Server         src.ElectrumServer // the connected server, or the last one tried
+	LastError      error
+	ConnectedSince time.Time
+	Reconnects     uint32
+}
+
+// ElectrumSupervisor
+// keeps a connection to one of Servers. The connection is checked with pings, a lost connection is replaced with
+// exponential backoff, trying the servers in order starting with the last one that worked. The block header and
+// scripthash subscriptions are renewed on every new connection, NewBlocks and ScripthashNotifications stay the same.
+type ElectrumSupervisor struct {
+	Servers        []src.ElectrumServer
+	TorProxyHost   string
+	PingInterval   time.Duration
+	PingTimeout    time.Duration
+	ReconnectDelay time.Duration
+	// ReconnectMaxGap caps the backoff between reconnection attempts
+	ReconnectMaxGap time.Duration
+
+	dial func(ctx context.Context, server src.ElectrumServer) (*electrum.Client, error)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu         sync.RWMutex
+	client     *electrum.Client
+	sub        *electrum.ScripthashSubscription
+	connDone   chan struct{} // closed when the current connection is given up
+	status     ElectrumStatus
+	next       int // index of the server which is tried first
+	everOnline bool
+	watched    map[string]struct{}
+
+	blocks chan *electrum.SubscribeHeadersResult
+	notifs chan *electrum.SubscribeNotif
+}
+
+func NewElectrumSupervisor(servers []src.ElectrumServer, torProxyHost string, pingInterval time.Duration) *ElectrumSupervisor {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &ElectrumSupervisor{
+		Servers:         servers,
+		TorProxyHost:    torProxyHost,
+		PingInterval:    pingInterval,
+		PingTimeout:     DefaultElectrumPingTimeout,
+		ReconnectDelay:  DefaultElectrumReconnectDelay,
+		ReconnectMaxGap: DefaultElectrumReconnectMaxGap,
+		ctx:             ctx,
+		cancel:          cancel,
+		watched:         make(map[string]struct{}),
+		blocks:          make(chan *electrum.SubscribeHeadersResult, 1),
+		notifs:          make(chan *electrum.SubscribeNotif, 16),
+	}
+	s.dial = func(ctx context.Context, server src.ElectrumServer) (*electrum.Client, error) {
+		if server.TLS {
+			return electrum.NewClientSSL(ctx, server.Address, electrumTLSConfig(server))
+		}
+		return electrum.NewClientTCP(ctx, server.Address, s.TorProxyHost)
+	}
+	return s
+}
+
+// CreateElectrumSupervisor creates the supervisor for the configured Electrum servers
+func CreateElectrumSupervisor() *ElectrumSupervisor {
+	return NewElectrumSupervisor(src.ElectrumServers, src.ElectrumTorProxyHost, src.ElectrumPingInterval)
+}
+
+// Start
+// tries every server once and then keeps supervising the connection in the background.
+// The daemon also starts without a connection, ErrElectrumUnavailable is returned until one is established.
+func (s *ElectrumSupervisor) Start() {
+	err := s.connectAny()
+	if err != nil {
+		logging.WarningLogger.Printf("no Electrum server reachable, retrying in the background: %s\n", err)
+	}
+	go s.run()
+}
+
+func (s *ElectrumSupervisor) Shutdown() {
+	s.cancel()
+	s.disconnect(nil)
+}
+
+// Client returns the client of the current connection
+func (s *ElectrumSupervisor) Client() (*electrum.Client, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.status.State != ElectrumConnected {
+		return nil, src.ErrElectrumUnavailable
+	}
+	return s.client, nil
+}
+
+func (s *ElectrumSupervisor) Status() ElectrumStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status
+}
+
+// NewBlocks delivers the chain tip on every connection and every new block after that
+func (s *ElectrumSupervisor) NewBlocks() <-chan *electrum.SubscribeHeadersResult {
+	return s.blocks
+}
+
+// ScripthashNotifications delivers the status changes of the scripthashes added with WatchScripthash
+func (s *ElectrumSupervisor) ScripthashNotifications() <-chan *electrum.SubscribeNotif {
+	return s.notifs
+}
+
+// WatchScripthash subscribes to scriptHash now, if connected, and on every later connection
+func (s *ElectrumSupervisor) WatchScripthash(ctx context.Context, scriptHash string) error {
+	s.mu.Lock()
+	s.watched[scriptHash] = struct{}{}
+	sub := s.sub
+	s.mu.Unlock()
+
+	if sub == nil {
+		return nil
+	}
+	return sub.Add(ctx, scriptHash)
+}
+
+func (s *ElectrumSupervisor) run() {
+	delay := s.ReconnectDelay
+	for {
+		s.mu.RLock()
+		client, connDone := s.client, s.connDone
+		connected := s.status.State == ElectrumConnected
+		s.mu.RUnlock()
+
+		if connected {
+			s.monitor(client, connDone)
+			delay = s.ReconnectDelay
+		} else {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			if s.connectAny() != nil {
+				delay = min(delay*2, s.ReconnectMaxGap)
+			}
+		}
+
+		if s.ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// monitor returns once the connection is lost or the supervisor is shut down
+func (s *ElectrumSupervisor) monitor(client *electrum.Client, connDone chan struct{}) {
+	ticker := time.NewTicker(s.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-connDone:
+			return
+		case err := <-client.Error:
+			logging.WarningLogger.Printf("lost connection to Electrum server %s: %s\n", s.Status().Server, err)
+			// the client shuts itself down after reporting the error
+			s.release(err)
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(s.ctx, s.PingTimeout)
+			err := client.Ping(ctx)
+			cancel()
+			if err != nil && s.ctx.Err() == nil {
+				logging.WarningLogger.Printf("Electrum server %s does not answer pings: %s\n", s.Status().Server, err)
+				s.disconnect(err)
+				return
+			}
+		}
+	}
+}
+
+// connectAny tries all servers once, starting with the last one that worked
+func (s *ElectrumSupervisor) connectAny() error {
+	if len(s.Servers) == 0 {
+		return src.ErrElectrumUnavailable
+	}
+
+	var err error
+	for i := range s.Servers {
+		index := (s.next + i) % len(s.Servers)
+		err = s.connect(index)
+		if err == nil {
+			return nil
+		}
+		logging.WarningLogger.Printf("could not connect to Electrum server %s: %s\n", s.Servers[index], err)
+		if s.ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *ElectrumSupervisor) connect(index int) error {
+	server := s.Servers[index]
+	s.mu.Lock()
+	s.status.State = ElectrumConnecting
+	s.status.Server = server
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(s.ctx, electrumDialTimeout)
 	defer cancel()
 
-	client, err := electrum.NewClientTCP(ctx, src.ElectrumServerAddress, src.ElectrumTorProxyHost)
+	client, err := s.dial(ctx, server)
+	if err != nil {
+		s.setError(err)
+		return err
+	}
+	headers, err := client.SubscribeHeaders(ctx)
+	if err != nil {
+		shutdownElectrumClient(client)
+		s.setError(err)
+		return err
+	}
+	sub, notifs := client.SubscribeScripthash()
+
+	connDone := make(chan struct{})
+	go s.forwardBlocks(headers, connDone)
+	go s.forwardNotifs(notifs, connDone)
+
+	s.mu.Lock()
+	if s.everOnline {
+		s.status.Reconnects++
+	}
+	s.everOnline = true
+	s.client, s.sub, s.connDone = client, sub, connDone
+	s.next = index
+	s.status.State = ElectrumConnected
+	s.status.LastError = nil
+	s.status.ConnectedSince = time.Now()
+	watched := make([]string, 0, len(s.watched))
+	for scriptHash := range s.watched {
+		watched = append(watched, scriptHash)
+	}
+	s.mu.Unlock()
+
+	logging.InfoLogger.Printf("connected to Electrum server %s\n", server)
+
+	// the current status of every scripthash is pushed again, changes while disconnected are picked up
+	for _, scriptHash := range watched {
+		err = sub.Add(ctx, scriptHash)
+		if err != nil {
+			err = fmt.Errorf("resubscribing: %w", err)
+			s.disconnect(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// disconnect gives up the current connection, err is the reason
+func (s *ElectrumSupervisor) disconnect(err error) {
+	client := s.release(err)
+	if client != nil {
+		shutdownElectrumClient(client)
+	}
+}
+
+// release marks the current connection as lost and returns its client
+func (s *ElectrumSupervisor) release(err error) *electrum.Client {
+	s.mu.Lock()
+	client, connDone := s.client, s.connDone
+	s.client, s.sub, s.connDone = nil, nil, nil
+	s.status.State = ElectrumDisconnected
+	s.status.ConnectedSince = time.Time{}
 	if err != nil {
-		logging.ErrorLogger.Println(err)
-		return nil, err
+		s.status.LastError = err
+	}
+	s.mu.Unlock()
+
+	if connDone != nil {
+		close(connDone)
+	}
+	return client
+}
+
+func (s *ElectrumSupervisor) setError(err error) {
+	s.mu.Lock()
+	s.status.State = ElectrumDisconnected
+	s.status.LastError = err
+	s.mu.Unlock()
+}
+
+// forwardBlocks only keeps the latest header if the daemon is busy, it syncs to the tip anyway
+func (s *ElectrumSupervisor) forwardBlocks(headers <-chan *electrum.SubscribeHeadersResult, connDone chan struct{}) {
+	for {
+		select {
+		case <-connDone:
+			return
+		case header := <-headers:
+			select {
+			case s.blocks <- header:
+			default:
+				select {
+				case <-s.blocks:
+				default:
+				}
+				s.blocks <- header
+			}
+		}
+	}
+}
+
+func (s *ElectrumSupervisor) forwardNotifs(notifs <-chan *electrum.SubscribeNotif, connDone chan struct{}) {
+	for {
+		select {
+		case <-connDone:
+			return
+		case notif := <-notifs:
+			select {
+			case s.notifs <- notif:
+			case <-connDone:
+				return
+			}
+		}
 	}
+}
+
+// electrumTLSConfig
+// verifies the certificate against the system CAs, or only against the fingerprint if one is pinned.
+// Electrum servers commonly use self-signed certificates.
+func electrumTLSConfig(server src.ElectrumServer) *tls.Config {
+	host, _, _ := net.SplitHostPort(server.Address)
+	config := &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	if server.Fingerprint == nil {
+		return config
+	}
+
+	config.InsecureSkipVerify = true
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return src.ErrElectrumCertificateMismatch
+		}
+		fingerprint := sha256.Sum256(state.PeerCertificates[0].Raw)
+		if !bytes.Equal(fingerprint[:], server.Fingerprint) {
+			return fmt.Errorf("%w: got %x", src.ErrElectrumCertificateMismatch, fingerprint)
+		}
+		return nil
+	}
+	return config
+}
 
-	return client, err
+// shutdownElectrumClient
+// closes the client. The client reports the closed connection on its unbuffered Error channel
+// and would block forever if nobody reads it.
+func shutdownElectrumClient(client *electrum.Client) {
+	client.Shutdown()
+	go func() {
+		select {
+		case <-client.Error:
+		case <-time.After(time.Minute):
+		}
+	}()
 }
@@ -0,0 +1,301 @@
+package networking
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/setavenger/go-electrum/electrum"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+// stubElectrum
+// is an Electrum server which serves the chain tip and the status of subscribed scripthashes.
+// Pings are not answered while mute is set.
+type stubElectrum struct {
+	listener    net.Listener
+	tip         int32
+	mute        atomic.Bool
+	connections atomic.Int32
+
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func newStubElectrum(t *testing.T) *stubElectrum {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return serveStubElectrum(t, listener)
+}
+
+// newStubElectrumTLS serves with a self-signed certificate and returns the certificate's fingerprint
+func newStubElectrumTLS(t *testing.T) (*stubElectrum, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "electrum"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certificate}, PrivateKey: key}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fingerprint := sha256.Sum256(certificate)
+	return serveStubElectrum(t, listener), fingerprint[:]
+}
+
+func serveStubElectrum(t *testing.T, listener net.Listener) *stubElectrum {
+	s := &stubElectrum{listener: listener, tip: 100}
+	t.Cleanup(func() {
+		_ = listener.Close()
+		s.dropConnections()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			s.connections.Add(1)
+			s.mu.Lock()
+			s.conns = append(s.conns, conn)
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *stubElectrum) serve(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+		var request struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		err = json.Unmarshal(line, &request)
+		if err != nil {
+			return
+		}
+
+		var result any
+		switch request.Method {
+		case "server.ping":
+			if s.mute.Load() {
+				continue
+			}
+		case "blockchain.headers.subscribe":
+			result = map[string]any{"height": s.tip, "hex": "00"}
+		case "blockchain.scripthash.subscribe":
+			result = "status-" + request.Params[0].(string)
+		}
+
+		response, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result})
+		_, err = conn.Write(append(response, '\n'))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *stubElectrum) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.conns = nil
+}
+
+// deadServer returns a server where nothing listens
+func deadServer(t *testing.T) src.ElectrumServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().String()
+	_ = listener.Close()
+	return src.ElectrumServer{Address: address}
+}
+
+func (s *stubElectrum) server() src.ElectrumServer {
+	return src.ElectrumServer{Address: s.listener.Addr().String()}
+}
+
+func newTestSupervisor(t *testing.T, servers ...src.ElectrumServer) *ElectrumSupervisor {
+	s := NewElectrumSupervisor(servers, "", 20*time.Millisecond)
+	s.PingTimeout = 50 * time.Millisecond
+	s.ReconnectDelay = 10 * time.Millisecond
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func receiveBlock(t *testing.T, s *ElectrumSupervisor) *electrum.SubscribeHeadersResult {
+	select {
+	case header := <-s.NewBlocks():
+		return header
+	case <-time.After(5 * time.Second):
+		t.Fatal("no block header received")
+		return nil
+	}
+}
+
+func receiveNotif(t *testing.T, s *ElectrumSupervisor) *electrum.SubscribeNotif {
+	select {
+	case notif := <-s.ScripthashNotifications():
+		return notif
+	case <-time.After(5 * time.Second):
+		t.Fatal("no scripthash notification received")
+		return nil
+	}
+}
+
+func waitForReconnects(t *testing.T, s *ElectrumSupervisor, reconnects uint32) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		status := s.Status()
+		if status.State == ElectrumConnected && status.Reconnects >= reconnects {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("not reconnected, status: %+v", s.Status())
+}
+
+func TestElectrumSupervisor(t *testing.T) {
+	server := newStubElectrum(t)
+	s := newTestSupervisor(t, deadServer(t), server.server())
+
+	_, err := s.Client()
+	if err == nil {
+		t.Fatal("client available before start")
+	}
+
+	s.Start()
+
+	status := s.Status()
+	if status.State != ElectrumConnected || status.Server.Address != server.server().Address {
+		t.Fatalf("not failed over to the second server: %+v", status)
+	}
+	if header := receiveBlock(t, s); header.Height != 100 {
+		t.Fatalf("tip %d, expected 100", header.Height)
+	}
+
+	const scriptHash = "aa"
+	err = s.WatchScripthash(context.Background(), scriptHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if notif := receiveNotif(t, s); notif.Params[1] != "status-"+scriptHash {
+		t.Fatalf("unexpected notification %v", notif.Params)
+	}
+
+	// the server goes away, the subscriptions are renewed on the next connection
+	server.dropConnections()
+	waitForReconnects(t, s, 1)
+
+	if header := receiveBlock(t, s); header.Height != 100 {
+		t.Fatalf("tip %d after reconnect, expected 100", header.Height)
+	}
+	if notif := receiveNotif(t, s); notif.Params[0] != scriptHash {
+		t.Fatalf("unexpected notification %v", notif.Params)
+	}
+
+	_, err = s.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Shutdown()
+	_, err = s.Client()
+	if err == nil {
+		t.Fatal("client available after shutdown")
+	}
+}
+
+func TestElectrumSupervisorPingTimeout(t *testing.T) {
+	server := newStubElectrum(t)
+	server.mute.Store(true)
+	s := newTestSupervisor(t, server.server())
+	s.Start()
+
+	// the connection is replaced once a ping is not answered
+	deadline := time.Now().Add(5 * time.Second)
+	for server.connections.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("unanswered pings did not lead to a reconnect, status: %+v", s.Status())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	server.mute.Store(false)
+
+	waitForReconnects(t, s, 1)
+	if err := s.Status().LastError; err != nil {
+		t.Fatalf("error not cleared after reconnect: %s", err)
+	}
+}
+
+func TestElectrumSupervisorTLS(t *testing.T) {
+	server, fingerprint := newStubElectrumTLS(t)
+
+	pinned := server.server()
+	pinned.TLS = true
+	pinned.Fingerprint = fingerprint
+	s := newTestSupervisor(t, pinned)
+	s.Start()
+	if header := receiveBlock(t, s); header.Height != 100 {
+		t.Fatalf("tip %d, expected 100", header.Height)
+	}
+
+	// without the pin the self-signed certificate is rejected
+	unpinned := server.server()
+	unpinned.TLS = true
+	s = newTestSupervisor(t, unpinned)
+	s.Start()
+	if status := s.Status(); status.State == ElectrumConnected || status.LastError == nil {
+		t.Fatalf("connected to a server with a self-signed certificate: %+v", status)
+	}
+
+	wrong := pinned
+	wrong.Fingerprint = make([]byte, 32)
+	s = newTestSupervisor(t, wrong)
+	s.Start()
+	if err := s.Status().LastError; !errors.Is(err, src.ErrElectrumCertificateMismatch) {
+		t.Fatalf("expected a fingerprint mismatch, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package networking
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+const (
+	// MaxResponseSize responses of the indexer above this size are rejected.
+	// The largest responses are the UTXOs and tweaks of full blocks which stay well below this.
+	MaxResponseSize = 64 << 20
+
+	DefaultRequestTimeout = 30 * time.Second
+	DefaultRetries        = 3
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+)
+
+// HTTPStatusError
+// is returned if the indexer answers with a status other than 200.
+// It unwraps to src.ErrIndexerNotFound, src.ErrIndexerUnavailable or src.ErrIndexerBadResponse.
+type HTTPStatusError struct {
+	Url        string
+	StatusCode int
+	Body       string // shortened
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("%s: status %d: %s", e.Url, e.StatusCode, e.Body)
+}
+
+func (e *HTTPStatusError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return src.ErrIndexerNotFound
+	case e.StatusCode == http.StatusTooManyRequests, e.StatusCode >= 500:
+		return src.ErrIndexerUnavailable
+	default:
+		return src.ErrIndexerBadResponse
+	}
+}
+
+// getJSON
+// fetches endpoint and decodes the JSON response into out. Network errors, timeouts, 5xx and 429 are retried
+// with exponential backoff. ctx cancels the request including all retries.
+func (c ClientBlindBit) getJSON(ctx context.Context, endpoint string, out any) error {
+	retries, delay := c.Retries, c.RetryBaseDelay
+	if retries == 0 {
+		retries = DefaultRetries
+	}
+	if delay == 0 {
+		delay = DefaultRetryBaseDelay
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := c.getJSONOnce(ctx, endpoint, out)
+		if err == nil {
+			return nil
+		}
+		if attempt > retries || !isRetryable(ctx, err) {
+			return err
+		}
+
+		logging.WarningLogger.Printf("indexer request failed (attempt %d/%d), retrying in %s: %s\n", attempt, retries+1, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (c ClientBlindBit) getJSONOnce(ctx context.Context, endpoint string, out any) error {
+	timeout := c.RequestTimeout
+	if timeout == 0 {
+		timeout = DefaultRequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := c.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxResponseSize+1))
+	if err != nil {
+		return err
+	}
+	if len(body) > MaxResponseSize {
+		return fmt.Errorf("%s: %w", endpoint, src.ErrIndexerResponseTooLarge)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		text := strings.TrimSpace(string(body))
+		if len(text) > 200 {
+			text = text[:200] + "..."
+		}
+		return &HTTPStatusError{Url: endpoint, StatusCode: resp.StatusCode, Body: text}
+	}
+
+	err = json.Unmarshal(body, out)
+	if err != nil {
+		return fmt.Errorf("%s: %w: %v", endpoint, src.ErrIndexerBadResponse, err)
+	}
+	return nil
+}
+
+// isRetryable is true for errors which can go away by themselves as long as ctx is not done
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, src.ErrIndexerUnavailable) {
+		return true
+	}
+	// transport errors including the timeout of a single attempt
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
@@ -0,0 +1,146 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"golang.org/x/net/proxy"
+	"google.golang.org/grpc"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// Indexer
+// serves the per block data needed to scan for silent payments.
+// ClientBlindBit talks JSON over HTTP, ClientBlindBitGRPC protobuf over gRPC.
+// Cancelling ctx aborts the request, each client additionally applies its own per request timeout.
+type Indexer interface {
+	GetChainTip(ctx context.Context) (uint64, error)
+	// GetTweaks serves the cut-through tweaks, GetTweakIndex the full index including spent transactions
+	GetTweaks(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error)
+	GetTweakIndex(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error)
+	GetFilter(ctx context.Context, blockHeight uint64, filterType FilterType) (*Filter, error)
+	GetUTXOs(ctx context.Context, blockHeight uint64) ([]*UTXOServed, error)
+	GetSpentOutpointsIndex(ctx context.Context, blockHeight uint64) (SpentOutpointsIndex, error)
+}
+
+// FeeEstimator is implemented by indexers which can also serve fee estimates
+type FeeEstimator interface {
+	GetFeeEstimates(ctx context.Context) (map[uint32]float64, error)
+}
+
+// CreateIndexer
+// creates the client for src.BlindBitServerAddresses with the configured src.IndexerProtocol.
+// Several addresses are combined in an IndexerPool. With IndexerProtocolBitcoind and IndexerProtocolP2P the data
+// is computed from the blocks of the node instead. With src.UseIndexerCache the data is cached on disk.
+func CreateIndexer() (Indexer, error) {
+	var indexer Indexer
+	if src.IndexerProtocol == src.IndexerProtocolBitcoind {
+		indexer = NewClientBitcoind(&BitcoindRPC{
+			Url:        src.BitcoindRpcUrl,
+			User:       src.BitcoindRpcUser,
+			Password:   src.BitcoindRpcPassword,
+			CookieFile: src.BitcoindRpcCookie,
+			HttpClient: &http.Client{},
+		})
+	} else if src.IndexerProtocol == src.IndexerProtocolP2P {
+		var err error
+		indexer, err = createClientP2P()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	} else if len(src.BlindBitServerAddresses) == 1 {
+		var err error
+		indexer, err = createIndexer(src.BlindBitServerAddresses[0])
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	} else {
+		members := make([]PoolMember, len(src.BlindBitServerAddresses))
+		for i, address := range src.BlindBitServerAddresses {
+			member, err := createIndexer(address)
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				return nil, err
+			}
+			members[i] = PoolMember{Name: address, Indexer: member}
+		}
+		indexer = NewIndexerPool(members, src.IndexerMaxLag, src.IndexerCrossCheck)
+	}
+
+	if !src.UseIndexerCache {
+		return indexer, nil
+	}
+	return NewIndexerCache(indexer, src.PathIndexerCache, src.IndexerCacheMaxSize)
+}
+
+// createIndexer
+// creates the client for a single indexer. If src.BlindBitTorProxyHost is set all requests go through Tor.
+// With src.BlindBitTorIsolation the client uses its own circuit for this session, separate from Electrum,
+// the broadcast backends and the other indexers.
+func createIndexer(address string) (Indexer, error) {
+	var auth *proxy.Auth
+	if src.BlindBitTorProxyHost != "" && src.BlindBitTorIsolation {
+		var err error
+		auth, err = NewTorIsolationAuth()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	}
+
+	switch src.IndexerProtocol {
+	case src.IndexerProtocolHTTP:
+		return &ClientBlindBit{
+			BaseUrl:    address,
+			HttpClient: NewIsolatedHttpClient(src.BlindBitTorProxyHost, auth),
+		}, nil
+	case src.IndexerProtocolGRPC:
+		if src.BlindBitTorProxyHost == "" {
+			return NewClientBlindBitGRPC(address)
+		}
+		dialer, err := NewTorDialer(src.BlindBitTorProxyHost, auth)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		// passthrough hands the address to the dialer as is, the default dns resolver would fail on onion addresses
+		return NewClientBlindBitGRPC("passthrough:///"+address, grpc.WithContextDialer(dialer))
+	default:
+		return nil, fmt.Errorf("unknown indexer protocol %q", src.IndexerProtocol)
+	}
+}
+
+// createClientP2P
+// creates the light client for src.P2PPeerAddress. The Tor settings of the indexer apply to the peer connection.
+func createClientP2P() (*ClientP2P, error) {
+	dial := (&net.Dialer{}).DialContext
+	if src.BlindBitTorProxyHost != "" {
+		var auth *proxy.Auth
+		if src.BlindBitTorIsolation {
+			var err error
+			auth, err = NewTorIsolationAuth()
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				return nil, err
+			}
+		}
+		torDial, err := NewTorDialer(src.BlindBitTorProxyHost, auth)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		dial = func(ctx context.Context, _, address string) (net.Conn, error) {
+			return torDial(ctx, address)
+		}
+	}
+
+	return NewClientP2P(src.P2PPeerAddress, src.ChainParams, func(ctx context.Context, address string) (net.Conn, error) {
+		return dial(ctx, "tcp", address)
+	}, src.PathP2PState, src.P2PStartHeight)
+}
@@ -0,0 +1,367 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// DefaultCacheSafeDepth blocks at least this deep are assumed to not be reorged anymore
+const DefaultCacheSafeDepth = 6
+
+type cacheEntry struct {
+	height uint64
+	size   int64
+	used   time.Time
+}
+
+// IndexerCache
+// keeps the tweaks, filters and UTXOs served by an indexer on disk, keyed by block height and hash, so that
+// rescans don't download them again. Files are named <height>-<block hash>-<kind>.
+//
+// For blocks at least SafeDepth below the tip the cached block hash is trusted and the data is served without
+// contacting the indexer. For more recent blocks the hash is read from the new-utxos filter first. If the indexer
+// serves another hash than cached, the block was reorged and all entries from that height on are dropped.
+// The least recently used entries are pruned once the cache exceeds MaxSize bytes.
+type IndexerCache struct {
+	Indexer
+	Dir       string
+	MaxSize   int64
+	SafeDepth uint64
+
+	mu      sync.Mutex
+	tip     uint64
+	hashes  map[uint64][32]byte
+	entries map[string]*cacheEntry // by file name
+	size    int64
+}
+
+// NewIndexerCache creates the cache in dir and loads the entries which are already on disk
+func NewIndexerCache(indexer Indexer, dir string, maxSize int64) (*IndexerCache, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	c := &IndexerCache{
+		Indexer:   indexer,
+		Dir:       dir,
+		MaxSize:   maxSize,
+		SafeDepth: DefaultCacheSafeDepth,
+		hashes:    make(map[uint64][32]byte),
+		entries:   make(map[string]*cacheEntry),
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	conflicts := make(map[uint64]bool)
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".tmp") {
+			// interrupted write
+			_ = os.Remove(filepath.Join(dir, file.Name()))
+			continue
+		}
+		height, hash, ok := parseCacheFileName(file.Name())
+		if !ok {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			continue
+		}
+		if known, exists := c.hashes[height]; exists && known != hash {
+			conflicts[height] = true
+		}
+		c.hashes[height] = hash
+		c.entries[file.Name()] = &cacheEntry{height: height, size: info.Size(), used: info.ModTime()}
+		c.size += info.Size()
+	}
+
+	// several hashes for one height are left over if dropping a reorged block was interrupted,
+	// without knowing which one is valid the height is fetched again
+	for name, entry := range c.entries {
+		if conflicts[entry.height] {
+			c.remove(name)
+			delete(c.hashes, entry.height)
+		}
+	}
+	c.prune()
+
+	return c, nil
+}
+
+func cacheFileName(height uint64, hash [32]byte, kind string) string {
+	return fmt.Sprintf("%d-%x-%s", height, hash, kind)
+}
+
+func parseCacheFileName(name string) (uint64, [32]byte, bool) {
+	var hash [32]byte
+	parts := strings.SplitN(name, "-", 3)
+	if len(parts) != 3 {
+		return 0, hash, false
+	}
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, hash, false
+	}
+	hashBytes, err := hex.DecodeString(parts[1])
+	if err != nil || len(hashBytes) != 32 {
+		return 0, hash, false
+	}
+	copy(hash[:], hashBytes)
+	return height, hash, true
+}
+
+func (c *IndexerCache) GetChainTip(ctx context.Context) (uint64, error) {
+	tip, err := c.Indexer.GetChainTip(ctx)
+	if err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	c.tip = tip
+	c.mu.Unlock()
+	return tip, nil
+}
+
+func (c *IndexerCache) GetTweaks(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	return cached(ctx, c, blockHeight, fmt.Sprintf("tweaks_%d", dustLimit), func() ([][33]byte, error) {
+		return c.Indexer.GetTweaks(ctx, blockHeight, dustLimit)
+	})
+}
+
+func (c *IndexerCache) GetTweakIndex(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	return cached(ctx, c, blockHeight, fmt.Sprintf("tweakindex_%d", dustLimit), func() ([][33]byte, error) {
+		return c.Indexer.GetTweakIndex(ctx, blockHeight, dustLimit)
+	})
+}
+
+// GetUTXOs
+// the Spent flag is only valid at the time the UTXOs are served, it is cleared in the cache.
+// Cached UTXOs are marked as spent by the spent outpoints filters while syncing.
+func (c *IndexerCache) GetUTXOs(ctx context.Context, blockHeight uint64) ([]*UTXOServed, error) {
+	var served []*UTXOServed
+	utxos, err := cached(ctx, c, blockHeight, "utxos", func() ([]*UTXOServed, error) {
+		var err error
+		served, err = c.Indexer.GetUTXOs(ctx, blockHeight)
+		if err != nil {
+			return nil, err
+		}
+		unspent := make([]*UTXOServed, len(served))
+		for i, utxo := range served {
+			copied := *utxo
+			copied.Spent = false
+			unspent[i] = &copied
+		}
+		return unspent, nil
+	})
+	if served != nil {
+		// fresh from the indexer
+		return served, err
+	}
+	return utxos, err
+}
+
+// GetFilter
+// filters carry the block hash themselves. Recent filters are always fetched, they are the reorg check for the block.
+func (c *IndexerCache) GetFilter(ctx context.Context, blockHeight uint64, filterType FilterType) (*Filter, error) {
+	kind := "filter_" + string(filterType)
+
+	c.mu.Lock()
+	hash, known := c.hashes[blockHeight]
+	deep := blockHeight+c.SafeDepth <= c.tip
+	c.mu.Unlock()
+
+	if known && deep {
+		var filter Filter
+		if c.load(cacheFileName(blockHeight, hash, kind), &filter) {
+			return &filter, nil
+		}
+	}
+
+	filter, err := c.Indexer.GetFilter(ctx, blockHeight, filterType)
+	if err != nil {
+		return nil, err
+	}
+	c.recordHash(blockHeight, filter.BlockHash)
+	c.store(cacheFileName(blockHeight, filter.BlockHash, kind), blockHeight, filter)
+	return filter, nil
+}
+
+// GetFeeEstimates passes the request on if the cached indexer can serve fee estimates
+func (c *IndexerCache) GetFeeEstimates(ctx context.Context) (map[uint32]float64, error) {
+	estimator, ok := c.Indexer.(FeeEstimator)
+	if !ok {
+		return nil, errors.New("indexer does not serve fee estimates")
+	}
+	return estimator.GetFeeEstimates(ctx)
+}
+
+// cached serves kind of blockHeight from the cache or fetches and stores it
+func cached[T any](ctx context.Context, c *IndexerCache, blockHeight uint64, kind string, fetch func() (T, error)) (T, error) {
+	var result T
+	hash, err := c.blockHash(ctx, blockHeight)
+	if err != nil {
+		return result, err
+	}
+	if c.load(cacheFileName(blockHeight, hash, kind), &result) {
+		return result, nil
+	}
+
+	result, err = fetch()
+	if err != nil {
+		return result, err
+	}
+	c.store(cacheFileName(blockHeight, hash, kind), blockHeight, result)
+	return result, nil
+}
+
+// blockHash
+// returns the cached hash for blocks which are deep enough, otherwise the hash is taken from the new-utxos filter
+func (c *IndexerCache) blockHash(ctx context.Context, blockHeight uint64) ([32]byte, error) {
+	c.mu.Lock()
+	hash, known := c.hashes[blockHeight]
+	deep := blockHeight+c.SafeDepth <= c.tip
+	c.mu.Unlock()
+	if known && deep {
+		return hash, nil
+	}
+
+	filter, err := c.GetFilter(ctx, blockHeight, NewUTXOFilterType)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return filter.BlockHash, nil
+}
+
+// recordHash
+// remembers the hash of blockHeight. A different hash than cached means a reorg,
+// all entries from blockHeight on are dropped.
+func (c *IndexerCache) recordHash(blockHeight uint64, hash [32]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	known, exists := c.hashes[blockHeight]
+	if exists && known == hash {
+		return
+	}
+	if exists {
+		logging.WarningLogger.Printf("block %d was reorged, dropping cached indexer data from this height on\n", blockHeight)
+		for name, entry := range c.entries {
+			if entry.height >= blockHeight {
+				c.remove(name)
+			}
+		}
+		for height := range c.hashes {
+			if height >= blockHeight {
+				delete(c.hashes, height)
+			}
+		}
+	}
+	c.hashes[blockHeight] = hash
+}
+
+func (c *IndexerCache) load(name string, out any) bool {
+	c.mu.Lock()
+	entry, ok := c.entries[name]
+	c.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.Dir, name))
+	if err == nil {
+		err = gob.NewDecoder(bytes.NewReader(data)).Decode(out)
+	}
+	if err != nil {
+		logging.WarningLogger.Printf("dropping unreadable cache entry %s: %s\n", name, err)
+		c.mu.Lock()
+		c.remove(name)
+		c.mu.Unlock()
+		return false
+	}
+
+	c.mu.Lock()
+	entry.used = time.Now()
+	c.mu.Unlock()
+	return true
+}
+
+// store writes the entry, errors only cost a download later and are logged
+func (c *IndexerCache) store(name string, height uint64, value any) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(value)
+	if err != nil {
+		logging.WarningLogger.Printf("could not encode cache entry %s: %s\n", name, err)
+		return
+	}
+
+	path := filepath.Join(c.Dir, name)
+	err = os.WriteFile(path+".tmp", buf.Bytes(), 0600)
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		logging.WarningLogger.Printf("could not write cache entry %s: %s\n", name, err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old, ok := c.entries[name]; ok {
+		c.size -= old.size
+	}
+	c.entries[name] = &cacheEntry{height: height, size: int64(buf.Len()), used: time.Now()}
+	c.size += int64(buf.Len())
+	c.prune()
+}
+
+// prune removes the least recently used entries until the cache fits into MaxSize, needs mu
+func (c *IndexerCache) prune() {
+	if c.MaxSize <= 0 || c.size <= c.MaxSize {
+		return
+	}
+
+	names := make([]string, 0, len(c.entries))
+	for name := range c.entries {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return c.entries[names[i]].used.Before(c.entries[names[j]].used)
+	})
+	for _, name := range names {
+		if c.size <= c.MaxSize {
+			return
+		}
+		c.remove(name)
+	}
+}
+
+// remove deletes an entry, needs mu
+func (c *IndexerCache) remove(name string) {
+	entry, ok := c.entries[name]
+	if !ok {
+		return
+	}
+	err := os.Remove(filepath.Join(c.Dir, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		logging.WarningLogger.Printf("could not remove cache entry %s: %s\n", name, err)
+	}
+	c.size -= entry.size
+	delete(c.entries, name)
+}
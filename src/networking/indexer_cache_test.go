@@ -0,0 +1,120 @@
+package networking
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIndexerCache(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	indexer := &fakeIndexer{tip: 100, tweaks: [][33]byte{{0x02}}, hash: [32]byte{0xaa}}
+
+	cache, err := NewIndexerCache(indexer, dir, 0)
+	if err != nil {
+		t.Fatalf("error creating cache: %v", err)
+	}
+	_, _ = cache.GetChainTip(ctx)
+
+	for i := 0; i < 2; i++ {
+		tweaks, err := cache.GetTweaks(ctx, 50, 1000)
+		if err != nil || len(tweaks) != 1 || tweaks[0][0] != 0x02 {
+			t.Errorf("wrong tweaks %x: %v", tweaks, err)
+		}
+	}
+	if indexer.tweakCalls != 1 {
+		t.Errorf("tweaks were fetched %d times", indexer.tweakCalls)
+	}
+
+	// a new session serves deep blocks without contacting the indexer
+	cache, err = NewIndexerCache(indexer, dir, 0)
+	if err != nil {
+		t.Fatalf("error reopening cache: %v", err)
+	}
+	_, _ = cache.GetChainTip(ctx)
+	indexer.calls = 0
+	_, err = cache.GetTweaks(ctx, 50, 1000)
+	if err != nil || indexer.calls != 0 {
+		t.Errorf("cached block was requested from the indexer, %d calls: %v", indexer.calls, err)
+	}
+	_, err = cache.GetTweaks(ctx, 50, 0)
+	if err != nil || indexer.tweakCalls != 2 {
+		t.Errorf("dust limit is not part of the key: %v", err)
+	}
+
+	// recent blocks are checked against the current block hash
+	indexer.tweakCalls = 0
+	_, _ = cache.GetTweaks(ctx, 98, 1000)
+	_, _ = cache.GetTweaks(ctx, 98, 1000)
+	if indexer.tweakCalls != 1 {
+		t.Errorf("recent tweaks were fetched %d times", indexer.tweakCalls)
+	}
+
+	indexer.hash = [32]byte{0xbb}
+	indexer.tweaks = [][33]byte{{0x03}}
+	tweaks, err := cache.GetTweaks(ctx, 98, 1000)
+	if err != nil || len(tweaks) != 1 || tweaks[0][0] != 0x03 || indexer.tweakCalls != 2 {
+		t.Errorf("reorged block was served from the cache: %x: %v", tweaks, err)
+	}
+	if cache.hashes[98] != indexer.hash {
+		t.Errorf("hash of the reorged block was not updated")
+	}
+}
+
+func TestIndexerCachePrune(t *testing.T) {
+	ctx := context.Background()
+	indexer := &fakeIndexer{tip: 1000, tweaks: make([][33]byte, 10)}
+
+	cache, err := NewIndexerCache(indexer, t.TempDir(), 2000)
+	if err != nil {
+		t.Fatalf("error creating cache: %v", err)
+	}
+	_, _ = cache.GetChainTip(ctx)
+
+	for height := uint64(1); height <= 20; height++ {
+		_, err = cache.GetTweaks(ctx, height, 0)
+		if err != nil {
+			t.Errorf("error getting tweaks: %v", err)
+		}
+	}
+	if cache.size > cache.MaxSize || len(cache.entries) == 0 {
+		t.Errorf("cache not pruned: %d bytes in %d entries", cache.size, len(cache.entries))
+	}
+	// the most recently used entries are kept
+	if _, ok := cache.entries[cacheFileName(20, indexer.hash, "tweaks_0")]; !ok {
+		t.Errorf("latest entry was pruned")
+	}
+}
+
+func TestIndexerCacheUTXOsSpent(t *testing.T) {
+	ctx := context.Background()
+	indexer := &fakeIndexer{tip: 100, utxos: []*UTXOServed{{Vout: 0, Spent: true}, {Vout: 1}}}
+
+	cache, err := NewIndexerCache(indexer, t.TempDir(), 0)
+	if err != nil {
+		t.Fatalf("error creating cache: %v", err)
+	}
+	_, _ = cache.GetChainTip(ctx)
+
+	utxos, err := cache.GetUTXOs(ctx, 50)
+	if err != nil || len(utxos) != 2 || !utxos[0].Spent {
+		t.Fatalf("fresh UTXOs lost the spent flag: %v", err)
+	}
+
+	// the spent flag of the indexer's response is not changed either
+	if !indexer.utxos[0].Spent {
+		t.Errorf("served UTXO was modified")
+	}
+
+	// the cached state would be outdated once an output is spent later
+	indexer.calls = 0
+	utxos, err = cache.GetUTXOs(ctx, 50)
+	if err != nil || indexer.calls != 0 || len(utxos) != 2 {
+		t.Fatalf("UTXOs not served from the cache, %d calls: %v", indexer.calls, err)
+	}
+	for _, utxo := range utxos {
+		if utxo.Spent {
+			t.Errorf("cached UTXO %d kept the spent flag", utxo.Vout)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// PoolMember is one indexer of an IndexerPool
+type PoolMember struct {
+	Name string // address, only used for logging
+	Indexer
+}
+
+// IndexerPool
+// implements Indexer on top of several indexers. Requests go to the active indexer and fail over to the
+// others in the configured order. GetChainTip re-selects the active indexer: the first one which is reachable
+// and at most MaxLag blocks behind the highest tip of all indexers.
+//
+// With CrossCheck the tweak index and filters are additionally fetched from a second indexer and compared.
+// Divergent data could hide payments, so the request fails with src.ErrIndexerDivergence instead of scanning the block.
+// Cut-through tweaks are not cross-checked, they depend on which outputs each indexer has seen spent
+// and indexers may be up to MaxLag blocks apart.
+type IndexerPool struct {
+	Members    []PoolMember
+	MaxLag     uint64
+	CrossCheck bool
+
+	mu     sync.Mutex
+	active int
+}
+
+func NewIndexerPool(members []PoolMember, maxLag uint64, crossCheck bool) *IndexerPool {
+	return &IndexerPool{Members: members, MaxLag: maxLag, CrossCheck: crossCheck}
+}
+
+// order returns the member indices starting with the active one
+func (p *IndexerPool) order() []int {
+	p.mu.Lock()
+	active := p.active
+	p.mu.Unlock()
+
+	order := make([]int, 0, len(p.Members))
+	for i := range p.Members {
+		order = append(order, (active+i)%len(p.Members))
+	}
+	return order
+}
+
+func (p *IndexerPool) setActive(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active != i {
+		logging.WarningLogger.Printf("switching indexer from %s to %s\n", p.Members[p.active].Name, p.Members[i].Name)
+		p.active = i
+	}
+}
+
+// GetChainTip
+// asks all indexers for their tip and returns the tip of the newly selected active indexer
+func (p *IndexerPool) GetChainTip(ctx context.Context) (uint64, error) {
+	tips := make([]uint64, len(p.Members))
+	errs := make([]error, len(p.Members))
+
+	var wg sync.WaitGroup
+	for i, member := range p.Members {
+		wg.Add(1)
+		go func(i int, member PoolMember) {
+			defer wg.Done()
+			tips[i], errs[i] = member.GetChainTip(ctx)
+		}(i, member)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for i := range p.Members {
+		if errs[i] == nil && tips[i] > highest {
+			highest = tips[i]
+		}
+	}
+
+	// the configured order is the priority, so the first indexer is used again once it has recovered
+	for i := range p.Members {
+		if errs[i] != nil || tips[i]+p.MaxLag < highest {
+			continue
+		}
+		p.setActive(i)
+		return tips[i], nil
+	}
+
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	return 0, p.joinErrors(errs)
+}
+
+func (p *IndexerPool) joinErrors(errs []error) error {
+	var joined []error
+	for i, err := range errs {
+		if err != nil {
+			joined = append(joined, fmt.Errorf("%s: %w", p.Members[i].Name, err))
+		}
+	}
+	return errors.Join(joined...)
+}
+
+// failover
+// calls fn for the active indexer and then for the others until one succeeds. The index of the indexer is returned.
+func failover[T any](ctx context.Context, p *IndexerPool, fn func(Indexer) (T, error)) (T, int, error) {
+	var result T
+	errs := make([]error, len(p.Members))
+	for _, i := range p.order() {
+		var err error
+		result, err = fn(p.Members[i].Indexer)
+		if err == nil {
+			p.setActive(i)
+			return result, i, nil
+		}
+		if ctx.Err() != nil {
+			return result, i, ctx.Err()
+		}
+		errs[i] = err
+		logging.WarningLogger.Printf("indexer %s failed: %s\n", p.Members[i].Name, err)
+	}
+	return result, 0, p.joinErrors(errs)
+}
+
+// crossCheck
+// fetches the same data from another indexer than used and compares the hashes.
+// If no other indexer can serve the data the result of used is accepted.
+func crossCheck[T any](ctx context.Context, p *IndexerPool, used int, what string, fn func(Indexer) (T, error), hash func(T) [32]byte, want T) error {
+	if !p.CrossCheck || len(p.Members) < 2 {
+		return nil
+	}
+
+	for _, i := range p.order() {
+		if i == used {
+			continue
+		}
+		other, err := fn(p.Members[i].Indexer)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logging.WarningLogger.Printf("cross-check of %s with %s failed: %s\n", what, p.Members[i].Name, err)
+			continue
+		}
+		if hash(other) != hash(want) {
+			err = fmt.Errorf("%w: %s differs between %s and %s", src.ErrIndexerDivergence, what, p.Members[used].Name, p.Members[i].Name)
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		return nil
+	}
+
+	logging.WarningLogger.Printf("%s could not be cross-checked, no other indexer is available\n", what)
+	return nil
+}
+
+func (p *IndexerPool) GetTweaks(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	tweaks, _, err := failover(ctx, p, func(indexer Indexer) ([][33]byte, error) {
+		return indexer.GetTweaks(ctx, blockHeight, dustLimit)
+	})
+	return tweaks, err
+}
+
+func (p *IndexerPool) GetTweakIndex(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	fn := func(indexer Indexer) ([][33]byte, error) {
+		return indexer.GetTweakIndex(ctx, blockHeight, dustLimit)
+	}
+	tweaks, used, err := failover(ctx, p, fn)
+	if err != nil {
+		return nil, err
+	}
+	err = crossCheck(ctx, p, used, fmt.Sprintf("tweak index of block %d", blockHeight), fn, hashTweaks, tweaks)
+	if err != nil {
+		return nil, err
+	}
+	return tweaks, nil
+}
+
+func (p *IndexerPool) GetFilter(ctx context.Context, blockHeight uint64, filterType FilterType) (*Filter, error) {
+	fn := func(indexer Indexer) (*Filter, error) {
+		return indexer.GetFilter(ctx, blockHeight, filterType)
+	}
+	filter, used, err := failover(ctx, p, fn)
+	if err != nil {
+		return nil, err
+	}
+	err = crossCheck(ctx, p, used, fmt.Sprintf("%s filter of block %d", filterType, blockHeight), fn, hashFilter, filter)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (p *IndexerPool) GetUTXOs(ctx context.Context, blockHeight uint64) ([]*UTXOServed, error) {
+	utxos, _, err := failover(ctx, p, func(indexer Indexer) ([]*UTXOServed, error) {
+		return indexer.GetUTXOs(ctx, blockHeight)
+	})
+	return utxos, err
+}
+
+func (p *IndexerPool) GetSpentOutpointsIndex(ctx context.Context, blockHeight uint64) (SpentOutpointsIndex, error) {
+	index, _, err := failover(ctx, p, func(indexer Indexer) (SpentOutpointsIndex, error) {
+		return indexer.GetSpentOutpointsIndex(ctx, blockHeight)
+	})
+	return index, err
+}
+
+// GetFeeEstimates asks the indexers which can serve fee estimates
+func (p *IndexerPool) GetFeeEstimates(ctx context.Context) (map[uint32]float64, error) {
+	estimates, _, err := failover(ctx, p, func(indexer Indexer) (map[uint32]float64, error) {
+		estimator, ok := indexer.(FeeEstimator)
+		if !ok {
+			return nil, errors.New("indexer does not serve fee estimates")
+		}
+		return estimator.GetFeeEstimates(ctx)
+	})
+	return estimates, err
+}
+
+// hashTweaks is independent of the order in which the indexer serves the tweaks
+func hashTweaks(tweaks [][33]byte) [32]byte {
+	sorted := make([][33]byte, len(tweaks))
+	copy(sorted, tweaks)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+
+	hasher := sha256.New()
+	for _, tweak := range sorted {
+		hasher.Write(tweak[:])
+	}
+	return [32]byte(hasher.Sum(nil))
+}
+
+func hashFilter(filter *Filter) [32]byte {
+	hasher := sha256.New()
+	hasher.Write([]byte{filter.FilterType})
+	hasher.Write(filter.BlockHash[:])
+	hasher.Write(filter.Data)
+	return [32]byte(hasher.Sum(nil))
+}
@@ -0,0 +1,140 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+// fakeIndexer serves fixed data, all requests fail if down is set
+type fakeIndexer struct {
+	tip        uint64
+	tweaks     [][33]byte
+	utxos      []*UTXOServed
+	hash       [32]byte // of every block
+	down       bool
+	calls      int
+	tweakCalls int
+}
+
+func (f *fakeIndexer) err() error {
+	f.calls++
+	if f.down {
+		return src.ErrIndexerUnavailable
+	}
+	return nil
+}
+
+func (f *fakeIndexer) GetChainTip(context.Context) (uint64, error) {
+	return f.tip, f.err()
+}
+
+func (f *fakeIndexer) GetTweaks(context.Context, uint64, uint64) ([][33]byte, error) {
+	f.tweakCalls++
+	return f.tweaks, f.err()
+}
+
+func (f *fakeIndexer) GetTweakIndex(context.Context, uint64, uint64) ([][33]byte, error) {
+	return f.tweaks, f.err()
+}
+
+func (f *fakeIndexer) GetFilter(_ context.Context, blockHeight uint64, _ FilterType) (*Filter, error) {
+	return &Filter{BlockHeight: blockHeight, BlockHash: f.hash}, f.err()
+}
+
+func (f *fakeIndexer) GetUTXOs(context.Context, uint64) ([]*UTXOServed, error) {
+	return f.utxos, f.err()
+}
+
+func (f *fakeIndexer) GetSpentOutpointsIndex(context.Context, uint64) (SpentOutpointsIndex, error) {
+	return SpentOutpointsIndex{}, f.err()
+}
+
+func newTestPool(crossCheck bool, indexers ...*fakeIndexer) *IndexerPool {
+	var members []PoolMember
+	for i, indexer := range indexers {
+		members = append(members, PoolMember{Name: string(rune('a' + i)), Indexer: indexer})
+	}
+	return NewIndexerPool(members, 2, crossCheck)
+}
+
+func TestIndexerPoolFailover(t *testing.T) {
+	first := &fakeIndexer{tip: 100, down: true}
+	second := &fakeIndexer{tip: 100, tweaks: [][33]byte{{0x02}}}
+	pool := newTestPool(false, first, second)
+
+	tweaks, err := pool.GetTweaks(context.Background(), 100, 0)
+	if err != nil || len(tweaks) != 1 {
+		t.Errorf("no failover: %v", err)
+	}
+	if pool.active != 1 {
+		t.Errorf("second indexer was not made active")
+	}
+
+	// the first indexer is preferred again once it recovered
+	first.down = false
+	tip, err := pool.GetChainTip(context.Background())
+	if err != nil || tip != 100 || pool.active != 0 {
+		t.Errorf("first indexer not selected again, active %d: %v", pool.active, err)
+	}
+
+	first.down, second.down = true, true
+	_, err = pool.GetUTXOs(context.Background(), 100)
+	if !errors.Is(err, src.ErrIndexerUnavailable) {
+		t.Errorf("expected both indexers to fail: %v", err)
+	}
+}
+
+func TestIndexerPoolLag(t *testing.T) {
+	lagging := &fakeIndexer{tip: 97}
+	current := &fakeIndexer{tip: 100}
+	pool := newTestPool(false, lagging, current)
+
+	tip, err := pool.GetChainTip(context.Background())
+	if err != nil || tip != 100 || pool.active != 1 {
+		t.Errorf("lagging indexer selected, tip %d: %v", tip, err)
+	}
+
+	// within the allowed lag the priority wins
+	lagging.tip = 98
+	tip, err = pool.GetChainTip(context.Background())
+	if err != nil || tip != 98 || pool.active != 0 {
+		t.Errorf("first indexer not selected within the lag, tip %d: %v", tip, err)
+	}
+}
+
+func TestIndexerPoolCrossCheck(t *testing.T) {
+	first := &fakeIndexer{tip: 100, tweaks: [][33]byte{{0x02}, {0x03}}}
+	second := &fakeIndexer{tip: 100, tweaks: [][33]byte{{0x03}, {0x02}}}
+	pool := newTestPool(true, first, second)
+
+	// the order of the tweaks does not matter
+	_, err := pool.GetTweakIndex(context.Background(), 100, 0)
+	if err != nil {
+		t.Errorf("equal tweaks reported as divergent: %v", err)
+	}
+	if second.calls != 1 {
+		t.Errorf("tweaks were not cross-checked")
+	}
+
+	second.tweaks = second.tweaks[:1]
+	_, err = pool.GetTweakIndex(context.Background(), 100, 0)
+	if !errors.Is(err, src.ErrIndexerDivergence) {
+		t.Errorf("divergence not detected: %v", err)
+	}
+
+	// cut-through tweaks differ with the spent outputs each indexer has seen
+	_, err = pool.GetTweaks(context.Background(), 100, 0)
+	if err != nil || second.tweakCalls != 0 {
+		t.Errorf("cut-through tweaks cross-checked: %v", err)
+	}
+
+	// without a second indexer the data is accepted
+	second.down = true
+	_, err = pool.GetTweakIndex(context.Background(), 100, 0)
+	if err != nil {
+		t.Errorf("tweaks rejected while the second indexer is down: %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil/gcs"
+	"github.com/btcsuite/btcd/btcutil/gcs/builder"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/go-bip352"
+
+	"github.com/setavenger/blindbitd/pb"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+)
+
+// blockCacheSize a block is needed by several requests while it is scanned, the last few are kept in memory
+const blockCacheSize = 4
+
+type localTx struct {
+	tweak        [33]byte
+	highestValue uint64 // of the taproot outputs, for the dust limit
+}
+
+// processedBlock is everything the scanning needs from one block
+type processedBlock struct {
+	hash        chainhash.Hash
+	txs         []localTx
+	utxos       []*UTXOServed
+	spentHashes [][8]byte
+}
+
+// localIndexer
+// serves the Indexer data from blocks which are processed locally instead of requesting it from an indexing server.
+// The backends only have to provide the processed blocks and the chain tip.
+//
+// Without an index of later spends the spent status of an output is unknown, so GetTweaks serves the full tweak
+// index and UTXOServed.Spent is always false. Spent outputs are found through the spent filters of the following blocks.
+type localIndexer struct {
+	block func(ctx context.Context, blockHeight uint64) (*processedBlock, error)
+}
+
+// GetTweaks serves the full tweak index, cut-through needs the spent status of every output
+func (l localIndexer) GetTweaks(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	return l.GetTweakIndex(ctx, blockHeight, dustLimit)
+}
+
+func (l localIndexer) GetTweakIndex(ctx context.Context, blockHeight, dustLimit uint64) ([][33]byte, error) {
+	block, err := l.block(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var tweaks [][33]byte
+	for _, tx := range block.txs {
+		if dustLimit > 0 && tx.highestValue < dustLimit {
+			continue
+		}
+		tweaks = append(tweaks, tx.tweak)
+	}
+	return tweaks, nil
+}
+
+func (l localIndexer) GetFilter(ctx context.Context, blockHeight uint64, filterType FilterType) (*Filter, error) {
+	block, err := l.block(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var values [][]byte
+	var filterTypeId uint8
+	switch filterType {
+	case NewUTXOFilterType:
+		filterTypeId = uint8(pb.FilterType_FILTER_TYPE_NEW_UTXOS)
+		for _, utxo := range block.utxos {
+			values = append(values, utxo.ScriptPubKey[2:])
+		}
+	case SpentOutpointsFilterType:
+		filterTypeId = uint8(pb.FilterType_FILTER_TYPE_SPENT)
+		for _, hash := range block.spentHashes {
+			values = append(values, hash[:])
+		}
+	default:
+		return nil, fmt.Errorf("unknown filter type %s", filterType)
+	}
+
+	filter, err := gcs.BuildGCSFilter(builder.DefaultP, builder.DefaultM, builder.DeriveKey(&block.hash), values)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	data, err := filter.NBytes()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	return &Filter{
+		FilterType:  filterTypeId,
+		BlockHeight: blockHeight,
+		BlockHash:   bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(block.hash[:])),
+		Data:        data,
+	}, nil
+}
+
+func (l localIndexer) GetUTXOs(ctx context.Context, blockHeight uint64) ([]*UTXOServed, error) {
+	block, err := l.block(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	return block.utxos, nil
+}
+
+func (l localIndexer) GetSpentOutpointsIndex(ctx context.Context, blockHeight uint64) (SpentOutpointsIndex, error) {
+	block, err := l.block(ctx, blockHeight)
+	if err != nil {
+		return SpentOutpointsIndex{}, err
+	}
+	return SpentOutpointsIndex{
+		BlockHash: bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(block.hash[:])),
+		Data:      block.spentHashes,
+	}, nil
+}
+
+// blockCache keeps the last processed blocks by height
+type blockCache struct {
+	mu     sync.Mutex
+	blocks map[uint64]*processedBlock
+	order  []uint64
+}
+
+// get returns the block at blockHeight if it is cached with the given hash, so a reorged block is not served
+func (c *blockCache) get(blockHeight uint64, hash chainhash.Hash) (*processedBlock, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	block, ok := c.blocks[blockHeight]
+	if !ok || block.hash != hash {
+		return nil, false
+	}
+	return block, true
+}
+
+func (c *blockCache) put(blockHeight uint64, block *processedBlock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.blocks == nil {
+		c.blocks = make(map[uint64]*processedBlock)
+	}
+	if _, exists := c.blocks[blockHeight]; !exists {
+		c.order = append(c.order, blockHeight)
+	}
+	c.blocks[blockHeight] = block
+	if len(c.order) > blockCacheSize {
+		delete(c.blocks, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+// processBlock
+// computes the tweaks, taproot UTXOs and hashes of the spent taproot outpoints of a block.
+// prevOuts holds the spent outputs for every transaction, the entry of the coinbase is ignored.
+func processBlock(blockHeight uint64, hash chainhash.Hash, timestamp uint64, txs []*wire.MsgTx, prevOuts []map[wire.OutPoint]*wire.TxOut) (*processedBlock, error) {
+	block := &processedBlock{hash: hash}
+	blockHashDisplay := bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(hash[:]))
+
+	for i, tx := range txs {
+		txHash := tx.TxHash()
+		txid := bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(txHash[:]))
+
+		var highestValue uint64
+		for vout, txOut := range tx.TxOut {
+			if !isTaprootScript(txOut.PkScript) {
+				continue
+			}
+			value := uint64(txOut.Value)
+			if value > highestValue {
+				highestValue = value
+			}
+			block.utxos = append(block.utxos, &UTXOServed{
+				Txid:         txid,
+				Vout:         uint32(vout),
+				Amount:       value,
+				ScriptPubKey: utils.ConvertToFixedLength34(txOut.PkScript),
+				BlockHeight:  blockHeight,
+				BlockHash:    blockHashDisplay,
+				Timestamp:    timestamp,
+			})
+		}
+
+		if i == 0 {
+			// coinbase
+			continue
+		}
+
+		for _, txIn := range tx.TxIn {
+			prevOut, ok := prevOuts[i][txIn.PreviousOutPoint]
+			if !ok {
+				return nil, fmt.Errorf("tx %s: prevout %s missing", txHash, txIn.PreviousOutPoint)
+			}
+			if isTaprootScript(prevOut.PkScript) {
+				block.spentHashes = append(block.spentHashes, spentOutpointHash(txIn.PreviousOutPoint, hash))
+			}
+		}
+
+		if highestValue == 0 || !eligibleForSilentPayments(tx, prevOuts[i]) {
+			continue
+		}
+		tweak, err := utils.ComputeTweak(tx, prevOuts[i])
+		if errors.Is(err, bip352.ErrNoEligibleVins) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tx %s: %w", txHash, err)
+		}
+		block.txs = append(block.txs, localTx{tweak: tweak, highestValue: highestValue})
+	}
+
+	return block, nil
+}
+
+// eligibleForSilentPayments
+// transactions which spend a segwit output of a version above 1 can't contain silent payments (BIP-352)
+func eligibleForSilentPayments(tx *wire.MsgTx, prevOuts map[wire.OutPoint]*wire.TxOut) bool {
+	for _, txIn := range tx.TxIn {
+		pkScript := prevOuts[txIn.PreviousOutPoint].PkScript
+		// OP_2 to OP_16 followed by a 2 to 40 byte push
+		if len(pkScript) >= 4 && len(pkScript) <= 42 && pkScript[0] >= 0x52 && pkScript[0] <= 0x60 && int(pkScript[1]) == len(pkScript)-2 {
+			return false
+		}
+	}
+	return true
+}
+
+// spentOutpointHash is the short hash of the spent outpoint salted with the spending block, see MarkSpentUTXOs
+func spentOutpointHash(outpoint wire.OutPoint, blockHash chainhash.Hash) [8]byte {
+	var buf bytes.Buffer
+	buf.Write(outpoint.Hash[:])
+	_ = binary.Write(&buf, binary.LittleEndian, outpoint.Index)
+	buf.Write(blockHash[:])
+
+	hashed := sha256.Sum256(buf.Bytes())
+	var shortHash [8]byte
+	copy(shortHash[:], hashed[:8])
+	return shortHash
+}
+
+func isTaprootScript(pkScript []byte) bool {
+	return len(pkScript) == 34 && pkScript[0] == 0x51 && pkScript[1] == 0x20
+}
@@ -0,0 +1,607 @@
+package networking
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/gcs"
+	"github.com/btcsuite/btcd/btcutil/gcs/builder"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+const (
+	// p2pSaveInterval the state is written to disk after this many processed blocks
+	p2pSaveInterval      = 1000
+	maxHeadersPerMessage = 2000
+	// DefaultP2PReorgSafeDepth spent taproot outputs are kept for this many blocks to restore them on a reorg
+	DefaultP2PReorgSafeDepth = 144
+	// spentOutputSize outpoint key and x-only key of a taproot output spent by a block, see storeSpentOutputs
+	spentOutputSize = 36 + 32
+)
+
+type taprootOutput struct {
+	Key    [32]byte
+	Height uint64
+}
+
+// p2pState is what ClientP2P keeps on disk
+type p2pState struct {
+	Headers     []byte // serialized, 80 bytes each, index is the height
+	StartHeight uint64
+	NextHeight  uint64 // the unspent taproot outputs of all blocks from StartHeight below this height are stored
+	Taproot     map[[36]byte]taprootOutput
+	// Spent the keys of the stored outputs spent in the recent blocks, by spending height.
+	// The outputs stay in Taproot until the spend is ReorgSafeDepth blocks deep, then they are moved to disk.
+	Spent map[uint64][][36]byte
+}
+
+// ClientP2P
+// implements Indexer as a light client over the Bitcoin P2P protocol, no indexing server is involved.
+// It follows the headers of a peer which serves BIP-157 compact filters, downloads every block and computes
+// tweaks, UTXOs and filters from it locally.
+//
+// Blocks on the P2P network don't contain the outputs spent by their inputs. The keys of P2WPKH, P2SH-P2WPKH and
+// P2PKH inputs are part of the spending data, the key of a taproot input is only in the spent output. ClientP2P
+// therefore stores the taproot UTXO set from StartHeight on, which has to be at or below the taproot activation.
+// Once the spend is ReorgSafeDepth blocks deep, spent outputs are moved from the state to one file per spending block
+// in <StatePath>-prevouts, where they are only read again to rescan that block. A deeper reorg processes all blocks again.
+// The prevout scripts derived this way are checked against the BIP-158 basic filter of the block, which commits to
+// all spent scripts, and a block whose prevouts can't be determined is an error instead of silently missing payments.
+//
+// The peer is trusted to serve the chain with the most work, headers are only checked for their proof of work.
+type ClientP2P struct {
+	localIndexer
+	Address     string
+	Params      *chaincfg.Params
+	Dial        func(ctx context.Context, address string) (net.Conn, error)
+	StatePath   string
+	StartHeight uint64
+	// ReorgSafeDepth zero falls back to DefaultP2PReorgSafeDepth
+	ReorgSafeDepth uint64
+
+	// mu is held while syncing headers or processing blocks
+	mu      sync.Mutex
+	peer    *Peer
+	state   p2pState
+	unsaved int
+	blocks  blockCache
+}
+
+// NewClientP2P loads the state from statePath if it exists
+func NewClientP2P(address string, params *chaincfg.Params, dial func(ctx context.Context, address string) (net.Conn, error), statePath string, startHeight uint64) (*ClientP2P, error) {
+	c := &ClientP2P{
+		Address:     address,
+		Params:      params,
+		Dial:        dial,
+		StatePath:   statePath,
+		StartHeight: startHeight,
+	}
+	c.localIndexer = localIndexer{block: c.blockData}
+
+	data, err := os.ReadFile(statePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	if err == nil {
+		err = gob.NewDecoder(bytes.NewReader(data)).Decode(&c.state)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+	}
+
+	genesis := c.Params.GenesisBlock.Header
+	if len(c.state.Headers) == 0 || c.header(0).BlockHash() != genesis.BlockHash() {
+		var buf bytes.Buffer
+		_ = genesis.Serialize(&buf)
+		c.state = p2pState{Headers: buf.Bytes(), StartHeight: startHeight, NextHeight: startHeight}
+	}
+	if c.state.Taproot == nil || c.state.StartHeight > startHeight {
+		// the outputs below a lowered start height are missing, the blocks are processed again
+		c.state.StartHeight, c.state.NextHeight = startHeight, startHeight
+		c.state.Taproot = make(map[[36]byte]taprootOutput)
+		c.state.Spent = nil
+		c.removeSpentOutputs()
+	}
+	if c.state.Spent == nil {
+		// states written before spent outputs were pruned keep them, they are just not pruned
+		c.state.Spent = make(map[uint64][][36]byte)
+	}
+
+	return c, nil
+}
+
+func (c *ClientP2P) GetChainTip(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.syncHeaders(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.tip(), nil
+}
+
+func (c *ClientP2P) tip() uint64 {
+	return uint64(len(c.state.Headers)/wire.MaxBlockHeaderPayload) - 1
+}
+
+func (c *ClientP2P) header(height uint64) *wire.BlockHeader {
+	header := new(wire.BlockHeader)
+	offset := height * wire.MaxBlockHeaderPayload
+	_ = header.Deserialize(bytes.NewReader(c.state.Headers[offset : offset+wire.MaxBlockHeaderPayload]))
+	return header
+}
+
+// connect returns the peer and dials a new connection if there is none
+func (c *ClientP2P) connect(ctx context.Context) (*Peer, error) {
+	if c.peer != nil && !c.peer.Closed() {
+		return c.peer, nil
+	}
+	peer, err := DialPeer(ctx, c.Address, c.Params, c.Dial)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, fmt.Errorf("%w: %v", src.ErrIndexerUnavailable, err)
+	}
+	c.peer = peer
+	return peer, nil
+}
+
+// syncHeaders follows the header chain of the peer, needs mu
+func (c *ClientP2P) syncHeaders(ctx context.Context) error {
+	peer, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		locator, heights := c.locator()
+		headers, err := peer.GetHeaders(ctx, locator)
+		complete := len(headers) < maxHeadersPerMessage
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return err
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+
+		forkHeight, ok := heights[headers[0].PrevBlock]
+		if !ok {
+			return fmt.Errorf("%w: headers don't connect to the chain", src.ErrIndexerBadResponse)
+		}
+		// with a sparse locator the first headers can be known already
+		for len(headers) > 0 && forkHeight < c.tip() && c.header(forkHeight+1).BlockHash() == headers[0].BlockHash() {
+			forkHeight++
+			headers = headers[1:]
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+		if forkHeight < c.tip() {
+			logging.WarningLogger.Printf("reorg, blocks from height %d on were replaced\n", forkHeight+1)
+			c.state.Headers = c.state.Headers[:(forkHeight+1)*wire.MaxBlockHeaderPayload]
+			c.dropFrom(forkHeight + 1)
+		}
+
+		var buf bytes.Buffer
+		prevHash := headers[0].PrevBlock
+		for _, header := range headers {
+			err = c.checkHeader(header, prevHash)
+			if err != nil {
+				return err
+			}
+			_ = header.Serialize(&buf)
+			prevHash = header.BlockHash()
+		}
+		c.state.Headers = append(c.state.Headers, buf.Bytes()...)
+
+		if complete {
+			return nil
+		}
+	}
+}
+
+func (c *ClientP2P) checkHeader(header *wire.BlockHeader, prevHash chainhash.Hash) error {
+	if header.PrevBlock != prevHash {
+		return fmt.Errorf("%w: headers don't form a chain", src.ErrIndexerBadResponse)
+	}
+	target := blockchain.CompactToBig(header.Bits)
+	hash := header.BlockHash()
+	if target.Sign() <= 0 || target.Cmp(c.Params.PowLimit) > 0 || blockchain.HashToBig(&hash).Cmp(target) > 0 {
+		return fmt.Errorf("%w: header %s has insufficient proof of work", src.ErrIndexerBadResponse, hash)
+	}
+	return nil
+}
+
+// locator returns the block locator of the chain and the heights of its hashes
+func (c *ClientP2P) locator() ([]*chainhash.Hash, map[chainhash.Hash]uint64) {
+	var locator []*chainhash.Hash
+	heights := make(map[chainhash.Hash]uint64)
+	step := uint64(1)
+	for height := c.tip(); ; height -= step {
+		hash := c.header(height).BlockHash()
+		locator = append(locator, &hash)
+		heights[hash] = height
+		if height == 0 {
+			return locator, heights
+		}
+		if len(locator) >= 10 {
+			step *= 2
+		}
+		if step > height {
+			step = height
+		}
+	}
+}
+
+func (c *ClientP2P) reorgSafeDepth() uint64 {
+	if c.ReorgSafeDepth == 0 {
+		return DefaultP2PReorgSafeDepth
+	}
+	return c.ReorgSafeDepth
+}
+
+// dropFrom
+// removes the taproot outputs of height and above, outputs spent from height on are unspent again.
+// If spent outputs which are needed again were pruned already, all blocks are processed again. Needs mu.
+func (c *ClientP2P) dropFrom(height uint64) {
+	if height+c.reorgSafeDepth() < c.state.NextHeight {
+		logging.WarningLogger.Printf("reorg from height %d is deeper than %d blocks, processing all blocks from %d again\n", height, c.reorgSafeDepth(), c.state.StartHeight)
+		c.state.NextHeight = c.state.StartHeight
+		c.state.Taproot = make(map[[36]byte]taprootOutput)
+		c.state.Spent = make(map[uint64][][36]byte)
+		c.removeSpentOutputs()
+		return
+	}
+
+	for spentHeight := range c.state.Spent {
+		if spentHeight >= height {
+			delete(c.state.Spent, spentHeight)
+		}
+	}
+	for key, output := range c.state.Taproot {
+		if output.Height >= height {
+			delete(c.state.Taproot, key)
+		}
+	}
+	if c.state.NextHeight > height {
+		c.state.NextHeight = height
+	}
+}
+
+func (c *ClientP2P) blockData(ctx context.Context, blockHeight uint64) (*processedBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if blockHeight > c.tip() {
+		err := c.syncHeaders(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if blockHeight > c.tip() {
+			return nil, fmt.Errorf("%w: block %d is above the tip", src.ErrIndexerNotFound, blockHeight)
+		}
+	}
+
+	hash := c.header(blockHeight).BlockHash()
+	if data, ok := c.blocks.get(blockHeight, hash); ok {
+		return data, nil
+	}
+
+	// the taproot outputs of all blocks before have to be known
+	for c.state.NextHeight < blockHeight {
+		_, err := c.fetchBlock(ctx, c.state.NextHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := c.fetchBlock(ctx, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	c.blocks.put(blockHeight, data)
+	return data, nil
+}
+
+// fetchBlock downloads and processes a block and stores its taproot outputs if it is the next block, needs mu
+func (c *ClientP2P) fetchBlock(ctx context.Context, blockHeight uint64) (*processedBlock, error) {
+	peer, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header := c.header(blockHeight)
+	hash := header.BlockHash()
+	block, err := peer.GetBlock(ctx, hash)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	err = checkBlock(block, header)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := peer.GetBasicFilter(ctx, uint32(blockHeight), hash)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	// a rescanned block might spend outputs which were already moved out of the state
+	var spentOutputs map[[36]byte][32]byte
+	if blockHeight < c.state.NextHeight {
+		spentOutputs, err = c.loadSpentOutputs(blockHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+	prevOuts, err := c.prevOuts(block, filter, spentOutputs)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, fmt.Errorf("block %d: %w", blockHeight, err)
+	}
+
+	data, err := processBlock(blockHeight, hash, uint64(header.Timestamp.Unix()), block.Transactions, prevOuts)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	if blockHeight == c.state.NextHeight {
+		c.applyBlock(blockHeight, block)
+		c.state.NextHeight++
+		c.unsaved++
+		if c.unsaved >= p2pSaveInterval || blockHeight == c.tip() {
+			c.save()
+		}
+	}
+	return data, nil
+}
+
+// applyBlock
+// adds the taproot outputs of the block to the stored ones and records which of them it spends.
+// Outputs spent ReorgSafeDepth blocks ago are moved to disk, needs mu.
+func (c *ClientP2P) applyBlock(blockHeight uint64, block *wire.MsgBlock) {
+	var spent [][36]byte
+	for i, tx := range block.Transactions {
+		if i > 0 {
+			for _, txIn := range tx.TxIn {
+				key := outpointKey(txIn.PreviousOutPoint)
+				if _, ok := c.state.Taproot[key]; ok {
+					spent = append(spent, key)
+				}
+			}
+		}
+
+		txHash := tx.TxHash()
+		for vout, txOut := range tx.TxOut {
+			if isTaprootScript(txOut.PkScript) {
+				output := taprootOutput{Height: blockHeight}
+				copy(output.Key[:], txOut.PkScript[2:])
+				c.state.Taproot[outpointKey(wire.OutPoint{Hash: txHash, Index: uint32(vout)})] = output
+			}
+		}
+	}
+	if len(spent) > 0 {
+		c.state.Spent[blockHeight] = spent
+	}
+
+	for spentHeight, keys := range c.state.Spent {
+		if spentHeight+c.reorgSafeDepth() > blockHeight {
+			continue
+		}
+		err := c.storeSpentOutputs(spentHeight, keys)
+		if err != nil {
+			// kept in the state and moved with the next block
+			logging.WarningLogger.Printf("could not store the outputs spent in block %d: %s\n", spentHeight, err)
+			continue
+		}
+		for _, key := range keys {
+			delete(c.state.Taproot, key)
+		}
+		delete(c.state.Spent, spentHeight)
+	}
+}
+
+func (c *ClientP2P) spentOutputsPath(height uint64) string {
+	return filepath.Join(c.StatePath+"-prevouts", strconv.FormatUint(height, 10))
+}
+
+// storeSpentOutputs writes the stored outputs with keys, which are spent by the block at height, to disk, needs mu
+func (c *ClientP2P) storeSpentOutputs(height uint64, keys [][36]byte) error {
+	data := make([]byte, 0, len(keys)*spentOutputSize)
+	for _, key := range keys {
+		output := c.state.Taproot[key]
+		data = append(data, key[:]...)
+		data = append(data, output.Key[:]...)
+	}
+
+	path := c.spentOutputsPath(height)
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(path+".tmp", data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// loadSpentOutputs returns the keys of the outputs spent by the block at height which were moved to disk, needs mu
+func (c *ClientP2P) loadSpentOutputs(height uint64) (map[[36]byte][32]byte, error) {
+	data, err := os.ReadFile(c.spentOutputsPath(height))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	if len(data)%spentOutputSize != 0 {
+		return nil, fmt.Errorf("spent outputs of block %d are corrupted", height)
+	}
+
+	outputs := make(map[[36]byte][32]byte, len(data)/spentOutputSize)
+	for i := 0; i < len(data); i += spentOutputSize {
+		outputs[[36]byte(data[i:i+36])] = [32]byte(data[i+36 : i+spentOutputSize])
+	}
+	return outputs, nil
+}
+
+// removeSpentOutputs deletes all spent outputs on disk when the blocks are processed again, needs mu
+func (c *ClientP2P) removeSpentOutputs() {
+	err := os.RemoveAll(c.StatePath + "-prevouts")
+	if err != nil {
+		logging.WarningLogger.Printf("could not remove the spent outputs: %s\n", err)
+	}
+}
+
+// checkBlock makes sure the block is the one committed to in header and has no transactions left out
+func checkBlock(block *wire.MsgBlock, header *wire.BlockHeader) error {
+	if len(block.Transactions) == 0 {
+		return fmt.Errorf("%w: block %s has no transactions", src.ErrIndexerBadResponse, header.BlockHash())
+	}
+	txs := make([]*btcutil.Tx, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		txs[i] = btcutil.NewTx(tx)
+	}
+	merkleRoot := blockchain.CalcMerkleRoot(txs, false)
+	if merkleRoot != header.MerkleRoot {
+		return fmt.Errorf("%w: transactions of block %s don't match the merkle root", src.ErrIndexerBadResponse, header.BlockHash())
+	}
+	return nil
+}
+
+// prevOuts
+// determines the spent outputs of all inputs of the block. Only the scripts are known, values are left at 0.
+// spentOutputs are the taproot outputs spent by the block which are no longer in the state, nil for new blocks.
+// Every script is checked against the basic filter, which contains the scripts of all spent outputs.
+func (c *ClientP2P) prevOuts(block *wire.MsgBlock, filter *gcs.Filter, spentOutputs map[[36]byte][32]byte) ([]map[wire.OutPoint]*wire.TxOut, error) {
+	blockHash := block.BlockHash()
+	filterKey := builder.DeriveKey(&blockHash)
+
+	inBlock := make(map[wire.OutPoint]*wire.TxOut)
+	prevOuts := make([]map[wire.OutPoint]*wire.TxOut, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		if i > 0 {
+			prevOuts[i] = make(map[wire.OutPoint]*wire.TxOut, len(tx.TxIn))
+			for _, txIn := range tx.TxIn {
+				prevOut, err := c.prevOut(txIn, inBlock, spentOutputs)
+				if err != nil {
+					return nil, err
+				}
+				if len(prevOut.PkScript) > 0 {
+					match, err := filter.Match(filterKey, prevOut.PkScript)
+					if err != nil {
+						return nil, err
+					}
+					if !match {
+						return nil, fmt.Errorf("%w: %s is not in the basic filter", src.ErrPrevOutUnknown, txIn.PreviousOutPoint)
+					}
+				}
+				prevOuts[i][txIn.PreviousOutPoint] = prevOut
+			}
+		}
+
+		txHash := tx.TxHash()
+		for vout, txOut := range tx.TxOut {
+			inBlock[wire.OutPoint{Hash: txHash, Index: uint32(vout)}] = txOut
+		}
+	}
+	return prevOuts, nil
+}
+
+// prevOut
+// returns the output spent by txIn. Outputs of the same block are known exactly, taproot outputs come from the
+// stored ones or spentOutputs. Otherwise the script is derived from the spending data, an empty script marks an
+// input which is not eligible for silent payments.
+func (c *ClientP2P) prevOut(txIn *wire.TxIn, inBlock map[wire.OutPoint]*wire.TxOut, spentOutputs map[[36]byte][32]byte) (*wire.TxOut, error) {
+	if txOut, ok := inBlock[txIn.PreviousOutPoint]; ok {
+		return txOut, nil
+	}
+	key := outpointKey(txIn.PreviousOutPoint)
+	if output, ok := c.state.Taproot[key]; ok {
+		return wire.NewTxOut(0, append([]byte{txscript.OP_1, txscript.OP_DATA_32}, output.Key[:]...)), nil
+	}
+	if outputKey, ok := spentOutputs[key]; ok {
+		return wire.NewTxOut(0, append([]byte{txscript.OP_1, txscript.OP_DATA_32}, outputKey[:]...)), nil
+	}
+
+	witness := txIn.Witness
+	pushes, err := txscript.PushedData(txIn.SignatureScript)
+	if err != nil {
+		pushes = nil
+	}
+
+	switch {
+	case len(witness) == 2 && isCompressedPubKey(witness[1]) && len(txIn.SignatureScript) == 0:
+		// p2wpkh
+		return wire.NewTxOut(0, append([]byte{txscript.OP_0, txscript.OP_DATA_20}, btcutil.Hash160(witness[1])...)), nil
+	case len(witness) == 2 && isCompressedPubKey(witness[1]) && len(pushes) == 1 && len(pushes[0]) == 22 &&
+		pushes[0][0] == txscript.OP_0 && pushes[0][1] == txscript.OP_DATA_20:
+		// p2sh-p2wpkh
+		script := append([]byte{txscript.OP_HASH160, txscript.OP_DATA_20}, btcutil.Hash160(pushes[0])...)
+		return wire.NewTxOut(0, append(script, txscript.OP_EQUAL)), nil
+	case len(witness) == 0 && len(pushes) == 2 && isPubKey(pushes[1]):
+		// p2pkh
+		script := append([]byte{txscript.OP_DUP, txscript.OP_HASH160, txscript.OP_DATA_20}, btcutil.Hash160(pushes[1])...)
+		return wire.NewTxOut(0, append(script, txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG)), nil
+	case len(witness) == 1 && (len(witness[0]) == 64 || len(witness[0]) == 65) && len(txIn.SignatureScript) == 0:
+		// looks like a taproot key path spend of an output which is not stored
+		return nil, fmt.Errorf("%w: %s, taproot outputs before the start height are not known", src.ErrPrevOutUnknown, txIn.PreviousOutPoint)
+	}
+	return wire.NewTxOut(0, nil), nil
+}
+
+// save writes the state to disk, errors only cost reprocessing blocks later and are logged, needs mu
+func (c *ClientP2P) save() {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(&c.state)
+	if err == nil {
+		err = os.WriteFile(c.StatePath+".tmp", buf.Bytes(), 0600)
+	}
+	if err == nil {
+		err = os.Rename(c.StatePath+".tmp", c.StatePath)
+	}
+	if err != nil {
+		logging.WarningLogger.Printf("could not save the p2p state: %s\n", err)
+		return
+	}
+	c.unsaved = 0
+}
+
+func outpointKey(outpoint wire.OutPoint) [36]byte {
+	var key [36]byte
+	copy(key[:], outpoint.Hash[:])
+	binary.LittleEndian.PutUint32(key[32:], outpoint.Index)
+	return key
+}
+
+func isCompressedPubKey(data []byte) bool {
+	return len(data) == 33 && (data[0] == 0x02 || data[0] == 0x03)
+}
+
+func isPubKey(data []byte) bool {
+	return isCompressedPubKey(data) || (len(data) == 65 && data[0] == 0x04)
+}
@@ -0,0 +1,255 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil/gcs"
+	"github.com/btcsuite/btcd/btcutil/gcs/builder"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/setavenger/blindbitd/src"
+)
+
+// RequiredPeerServices a peer has to serve witness blocks and compact block filters (BIP-157)
+const RequiredPeerServices = wire.SFNodeNetwork | wire.SFNodeWitness | wire.SFNodeCF
+
+// Peer
+// is a connection to a Bitcoin node over the P2P protocol. It only requests headers, blocks and
+// BIP-158 basic filters, one request at a time. Transactions are not relayed to us.
+type Peer struct {
+	Address  string
+	Services wire.ServiceFlag
+
+	conn   net.Conn
+	params *chaincfg.Params
+
+	writeMu sync.Mutex
+	reqMu   sync.Mutex
+
+	mu      sync.Mutex
+	pending func(wire.Message) bool
+	resp    chan wire.Message
+
+	done chan struct{}
+	err  error
+}
+
+// DialPeer connects to address and completes the version handshake
+func DialPeer(ctx context.Context, address string, params *chaincfg.Params, dial func(ctx context.Context, address string) (net.Conn, error)) (*Peer, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultRequestTimeout)
+	defer cancel()
+
+	conn, err := dial(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Peer{
+		Address: address,
+		conn:    conn,
+		params:  params,
+		resp:    make(chan wire.Message, 1),
+		done:    make(chan struct{}),
+	}
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+	err = p.handshake()
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("handshake with %s: %w", address, err)
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	go p.readLoop()
+	return p, nil
+}
+
+func (p *Peer) handshake() error {
+	me := wire.NewNetAddressIPPort(net.IPv4zero, 0, 0)
+	you := wire.NewNetAddressIPPort(net.IPv4zero, 0, 0)
+	version := wire.NewMsgVersion(me, you, rand.Uint64(), 0)
+	version.UserAgent = "/blindbitd/"
+	version.DisableRelayTx = true
+	err := p.write(version)
+	if err != nil {
+		return err
+	}
+
+	var gotVersion, gotVerAck bool
+	for !gotVersion || !gotVerAck {
+		msg, err := p.read()
+		if err != nil {
+			return err
+		}
+		switch m := msg.(type) {
+		case *wire.MsgVersion:
+			if m.Services&RequiredPeerServices != RequiredPeerServices {
+				return fmt.Errorf("peer does not serve witness blocks and compact filters, services: %s", m.Services)
+			}
+			p.Services = m.Services
+			gotVersion = true
+			err = p.write(wire.NewMsgVerAck())
+			if err != nil {
+				return err
+			}
+		case *wire.MsgVerAck:
+			gotVerAck = true
+		}
+	}
+	return nil
+}
+
+// read returns the next known message, unknown and malformed messages are skipped
+func (p *Peer) read() (wire.Message, error) {
+	for {
+		_, msg, _, err := wire.ReadMessageWithEncodingN(p.conn, wire.ProtocolVersion, p.params.Net, wire.WitnessEncoding)
+		var msgErr *wire.MessageError
+		if errors.Is(err, wire.ErrUnknownMessage) || errors.As(err, &msgErr) {
+			continue
+		}
+		return msg, err
+	}
+}
+
+func (p *Peer) write(msg wire.Message) error {
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	_, err := wire.WriteMessageWithEncodingN(p.conn, msg, wire.ProtocolVersion, p.params.Net, wire.WitnessEncoding)
+	return err
+}
+
+func (p *Peer) readLoop() {
+	for {
+		msg, err := p.read()
+		if err != nil {
+			p.err = err
+			close(p.done)
+			return
+		}
+
+		if ping, ok := msg.(*wire.MsgPing); ok {
+			_ = p.write(wire.NewMsgPong(ping.Nonce))
+			continue
+		}
+
+		p.mu.Lock()
+		if p.pending != nil && p.pending(msg) {
+			p.pending = nil
+			p.resp <- msg
+		}
+		p.mu.Unlock()
+	}
+}
+
+// request sends msg and waits for the first message accepted by match
+func (p *Peer) request(ctx context.Context, msg wire.Message, match func(wire.Message) bool) (wire.Message, error) {
+	p.reqMu.Lock()
+	defer p.reqMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultRequestTimeout)
+	defer cancel()
+
+	p.mu.Lock()
+	p.pending = match
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.pending = nil
+		// a response which arrived after the request was given up on
+		select {
+		case <-p.resp:
+		default:
+		}
+		p.mu.Unlock()
+	}()
+
+	err := p.write(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case resp := <-p.resp:
+		return resp, nil
+	case <-p.done:
+		return nil, fmt.Errorf("%w: connection to %s lost: %v", src.ErrIndexerUnavailable, p.Address, p.err)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// GetHeaders requests the headers following the first hash of locator which the peer knows, at most 2000
+func (p *Peer) GetHeaders(ctx context.Context, locator []*chainhash.Hash) ([]*wire.BlockHeader, error) {
+	msg := wire.NewMsgGetHeaders()
+	msg.BlockLocatorHashes = locator
+	resp, err := p.request(ctx, msg, func(m wire.Message) bool {
+		_, ok := m.(*wire.MsgHeaders)
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*wire.MsgHeaders).Headers, nil
+}
+
+func (p *Peer) GetBlock(ctx context.Context, hash chainhash.Hash) (*wire.MsgBlock, error) {
+	msg := wire.NewMsgGetData()
+	_ = msg.AddInvVect(wire.NewInvVect(wire.InvTypeWitnessBlock, &hash))
+	resp, err := p.request(ctx, msg, func(m wire.Message) bool {
+		switch m := m.(type) {
+		case *wire.MsgBlock:
+			return m.BlockHash() == hash
+		case *wire.MsgNotFound:
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	block, ok := resp.(*wire.MsgBlock)
+	if !ok {
+		return nil, fmt.Errorf("%w: block %s", src.ErrIndexerNotFound, hash)
+	}
+	return block, nil
+}
+
+// GetBasicFilter requests the BIP-158 basic filter of the block hash at height
+func (p *Peer) GetBasicFilter(ctx context.Context, height uint32, hash chainhash.Hash) (*gcs.Filter, error) {
+	msg := wire.NewMsgGetCFilters(wire.GCSFilterRegular, height, &hash)
+	resp, err := p.request(ctx, msg, func(m wire.Message) bool {
+		filter, ok := m.(*wire.MsgCFilter)
+		return ok && filter.BlockHash == hash
+	})
+	if err != nil {
+		return nil, err
+	}
+	filter, err := gcs.FromNBytes(builder.DefaultP, builder.DefaultM, resp.(*wire.MsgCFilter).Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: filter of block %s: %v", src.ErrIndexerBadResponse, hash, err)
+	}
+	return filter, nil
+}
+
+func (p *Peer) Close() error {
+	return p.conn.Close()
+}
+
+// Closed reports whether the connection was lost
+func (p *Peer) Closed() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,366 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/gcs/builder"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/go-bip352"
+
+	"github.com/setavenger/blindbitd/src"
+	"github.com/setavenger/blindbitd/src/utils"
+)
+
+// stubPeer is a regtest node serving headers, blocks and basic filters of a chain it is given
+type stubPeer struct {
+	t        *testing.T
+	listener net.Listener
+
+	mu       sync.Mutex
+	blocks   []*wire.MsgBlock
+	prevOuts map[wire.OutPoint][]byte // scripts of the spent outputs, for the basic filters
+}
+
+func newStubPeer(t *testing.T) *stubPeer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &stubPeer{
+		t:        t,
+		listener: listener,
+		blocks:   []*wire.MsgBlock{chaincfg.RegressionNetParams.GenesisBlock},
+		prevOuts: make(map[wire.OutPoint][]byte),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go p.serve(conn)
+		}
+	}()
+	return p
+}
+
+// mine appends a block with txs on top of height-1, replacing the blocks from height on
+func (p *stubPeer) mine(height int, txs ...*wire.MsgTx) *wire.MsgBlock {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	coinbase := wire.NewMsgTx(2)
+	coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex}, []byte{0x01, byte(height), byte(len(p.blocks))}, nil))
+	coinbase.AddTxOut(wire.NewTxOut(50_000, []byte{0x00, 0x14, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14}))
+	block := wire.NewMsgBlock(&wire.BlockHeader{
+		Version:   4,
+		PrevBlock: p.blocks[height-1].BlockHash(),
+		Timestamp: time.Unix(1700000000+int64(height)*600, 0),
+		Bits:      chaincfg.RegressionNetParams.PowLimitBits,
+	})
+	_ = block.AddTransaction(coinbase)
+	for _, tx := range txs {
+		_ = block.AddTransaction(tx)
+	}
+	btcTxs := make([]*btcutil.Tx, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		btcTxs[i] = btcutil.NewTx(tx)
+	}
+	block.Header.MerkleRoot = blockchain.CalcMerkleRoot(btcTxs, false)
+	target := blockchain.CompactToBig(block.Header.Bits)
+	for {
+		hash := block.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			break
+		}
+		block.Header.Nonce++
+	}
+
+	p.blocks = append(p.blocks[:height], block)
+	return block
+}
+
+func (p *stubPeer) write(conn net.Conn, msg wire.Message) {
+	_, _ = wire.WriteMessageWithEncodingN(conn, msg, wire.ProtocolVersion, chaincfg.RegressionNetParams.Net, wire.WitnessEncoding)
+}
+
+func (p *stubPeer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		_, msg, _, err := wire.ReadMessageWithEncodingN(conn, wire.ProtocolVersion, chaincfg.RegressionNetParams.Net, wire.WitnessEncoding)
+		if err != nil {
+			return
+		}
+
+		p.mu.Lock()
+		switch m := msg.(type) {
+		case *wire.MsgVersion:
+			me := wire.NewNetAddressIPPort(net.IPv4zero, 0, 0)
+			version := wire.NewMsgVersion(me, me, 1, int32(len(p.blocks)-1))
+			version.Services = RequiredPeerServices
+			p.write(conn, version)
+			p.write(conn, wire.NewMsgVerAck())
+			// the client has to answer pings and skip messages it does not request
+			p.write(conn, wire.NewMsgPing(7))
+			p.write(conn, wire.NewMsgSendHeaders())
+		case *wire.MsgGetHeaders:
+			start := 0
+		locator:
+			for _, hash := range m.BlockLocatorHashes {
+				for height, block := range p.blocks {
+					if block.BlockHash() == *hash {
+						start = height + 1
+						break locator
+					}
+				}
+			}
+			headers := wire.NewMsgHeaders()
+			for _, block := range p.blocks[start:] {
+				_ = headers.AddBlockHeader(&block.Header)
+			}
+			p.write(conn, headers)
+		case *wire.MsgGetData:
+			for _, block := range p.blocks {
+				if block.BlockHash() == m.InvList[0].Hash {
+					p.write(conn, block)
+				}
+			}
+		case *wire.MsgGetCFilters:
+			block := p.blocks[m.StartHeight]
+			var scripts [][]byte
+			for _, tx := range block.Transactions[1:] {
+				for _, txIn := range tx.TxIn {
+					scripts = append(scripts, p.prevOuts[txIn.PreviousOutPoint])
+				}
+			}
+			filter, err := builder.BuildBasicFilter(block, scripts)
+			if err != nil {
+				p.t.Error(err)
+			}
+			data, _ := filter.NBytes()
+			hash := block.BlockHash()
+			p.write(conn, wire.NewMsgCFilter(wire.GCSFilterRegular, &hash, data))
+		}
+		p.mu.Unlock()
+	}
+}
+
+func (p *stubPeer) client(t *testing.T, startHeight uint64) *ClientP2P {
+	dial := func(ctx context.Context, address string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	}
+	client, err := NewClientP2P(p.listener.Addr().String(), &chaincfg.RegressionNetParams, dial, filepath.Join(t.TempDir(), "p2p-state"), startHeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func p2wpkhScript(pubKey [33]byte) []byte {
+	return append([]byte{0x00, 0x14}, btcutil.Hash160(pubKey[:])...)
+}
+
+func taprootScript(pubKey [33]byte) []byte {
+	return append([]byte{0x51, 0x20}, pubKey[1:]...)
+}
+
+// testP2PChain
+// mines a taproot output in block 1 and spends it together with a p2wpkh output in block 2.
+// Returns the spending transaction and its prevouts.
+func testP2PChain(p *stubPeer) (*wire.MsgTx, map[wire.OutPoint]*wire.TxOut) {
+	_, walletPubKey := testSecKey(3)
+	_, taprootPubKey := testSecKey(5)
+
+	funding := wire.NewMsgTx(2)
+	fundingPrev := wire.OutPoint{Hash: chainhash.DoubleHashH([]byte("funding")), Index: 0}
+	funding.AddTxIn(wire.NewTxIn(&fundingPrev, nil, [][]byte{make([]byte, 71), walletPubKey[:]}))
+	funding.AddTxOut(wire.NewTxOut(20_000, taprootScript(taprootPubKey)))
+	p.prevOuts[fundingPrev] = p2wpkhScript(walletPubKey)
+	p.mine(1, funding)
+
+	spending := wire.NewMsgTx(2)
+	taprootPrev := wire.OutPoint{Hash: funding.TxHash(), Index: 0}
+	walletPrev := wire.OutPoint{Hash: chainhash.DoubleHashH([]byte("wallet")), Index: 3}
+	spending.AddTxIn(wire.NewTxIn(&taprootPrev, nil, [][]byte{make([]byte, 64)}))
+	spending.AddTxIn(wire.NewTxIn(&walletPrev, nil, [][]byte{make([]byte, 71), walletPubKey[:]}))
+	_, outputPubKey := testSecKey(6)
+	spending.AddTxOut(wire.NewTxOut(5000, taprootScript(outputPubKey)))
+	spending.AddTxOut(wire.NewTxOut(10_000, p2wpkhScript(walletPubKey)))
+	p.prevOuts[taprootPrev] = taprootScript(taprootPubKey)
+	p.prevOuts[walletPrev] = p2wpkhScript(walletPubKey)
+	p.mine(2, spending)
+
+	return spending, map[wire.OutPoint]*wire.TxOut{
+		taprootPrev: wire.NewTxOut(20_000, taprootScript(taprootPubKey)),
+		walletPrev:  wire.NewTxOut(10_000, p2wpkhScript(walletPubKey)),
+	}
+}
+
+func TestClientP2P(t *testing.T) {
+	peer := newStubPeer(t)
+	defer peer.listener.Close()
+	spending, prevOuts := testP2PChain(peer)
+
+	client := peer.client(t, 0)
+	ctx := context.Background()
+
+	tip, err := client.GetChainTip(ctx)
+	if err != nil || tip != 2 {
+		t.Fatalf("wrong chain tip %d: %v", tip, err)
+	}
+
+	tweaks, err := client.GetTweaks(ctx, 2, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the key of the taproot input is only known from the output stored with block 1
+	expectedTweak, err := utils.ComputeTweak(spending, prevOuts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tweaks) != 1 || tweaks[0] != expectedTweak {
+		t.Fatalf("wrong tweaks %x, expected %x", tweaks, expectedTweak)
+	}
+
+	utxos, err := client.GetUTXOs(ctx, 2)
+	spendingHash := spending.TxHash()
+	if err != nil || len(utxos) != 1 || utxos[0].Txid != bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(spendingHash[:])) {
+		t.Errorf("wrong utxos %v: %v", utxos, err)
+	}
+
+	blockHash := peer.blocks[2].BlockHash()
+	spentIndex, err := client.GetSpentOutpointsIndex(ctx, 2)
+	if err != nil || len(spentIndex.Data) != 1 || spentIndex.Data[0] != spentOutpointHash(spending.TxIn[0].PreviousOutPoint, blockHash) {
+		t.Errorf("wrong spent index %x: %v", spentIndex.Data, err)
+	}
+
+	// the state is reused by the next client
+	client.mu.Lock()
+	client.save()
+	client.mu.Unlock()
+	reloaded, err := NewClientP2P(client.Address, client.Params, client.Dial, client.StatePath, 0)
+	if err != nil || reloaded.tip() != 2 || reloaded.state.NextHeight != 3 || len(reloaded.state.Taproot) != 2 {
+		t.Errorf("state not reloaded: %v", err)
+	}
+
+	// block 2 is replaced, the taproot output of block 1 stays known
+	peer.mine(2)
+	peer.mine(3)
+	tip, err = client.GetChainTip(ctx)
+	if err != nil || tip != 3 {
+		t.Fatalf("wrong chain tip after reorg %d: %v", tip, err)
+	}
+	utxos, err = client.GetUTXOs(ctx, 2)
+	if err != nil || len(utxos) != 0 {
+		t.Errorf("utxos of the reorged block served %v: %v", utxos, err)
+	}
+	_, err = client.GetTweaks(ctx, 3, 0)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(client.state.Taproot) != 1 {
+		t.Errorf("expected only the taproot output of block 1, got %d", len(client.state.Taproot))
+	}
+}
+
+func TestClientP2PPruneSpent(t *testing.T) {
+	peer := newStubPeer(t)
+	defer peer.listener.Close()
+	spending, prevOuts := testP2PChain(peer)
+	peer.mine(3)
+	peer.mine(4)
+
+	client := peer.client(t, 0)
+	client.ReorgSafeDepth = 2
+	ctx := context.Background()
+
+	// the taproot output of block 1 is spent in block 2 and kept until block 4
+	_, err := client.GetTweaks(ctx, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(client.state.Taproot) != 2 || len(client.state.Spent[2]) != 1 {
+		t.Errorf("spent output not kept for reorgs: %d outputs, %d spent", len(client.state.Taproot), len(client.state.Spent[2]))
+	}
+	_, err = client.GetTweaks(ctx, 4, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(client.state.Taproot) != 1 || len(client.state.Spent) != 0 {
+		t.Errorf("spent output not pruned: %d outputs, %d spent", len(client.state.Taproot), len(client.state.Spent))
+	}
+
+	// a rescan of block 2, e.g. after adding a label, reads the pruned output from disk
+	client.blocks = blockCache{}
+	tweaks, err := client.GetTweaks(ctx, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedTweak, err := utils.ComputeTweak(spending, prevOuts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tweaks) != 1 || tweaks[0] != expectedTweak {
+		t.Errorf("wrong tweaks after pruning %x, expected %x", tweaks, expectedTweak)
+	}
+	if client.state.NextHeight != 5 || len(client.state.Taproot) != 1 {
+		t.Errorf("state changed by the rescan: %d outputs, next height %d", len(client.state.Taproot), client.state.NextHeight)
+	}
+
+	// the pruned output is needed again after a deeper reorg, all blocks are processed again
+	peer.mine(2)
+	peer.mine(3)
+	peer.mine(4)
+	peer.mine(5)
+	_, err = client.GetTweaks(ctx, 5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(client.state.Taproot) != 1 || client.state.NextHeight != 6 {
+		t.Errorf("state not rebuilt after a deep reorg: %d outputs, next height %d", len(client.state.Taproot), client.state.NextHeight)
+	}
+	if _, ok := client.state.Taproot[outpointKey(wire.OutPoint{Hash: peer.blocks[1].Transactions[1].TxHash()})]; !ok {
+		t.Errorf("output of block 1 is not unspent after the reorg")
+	}
+	if _, err = os.Stat(client.spentOutputsPath(2)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("spent outputs of the reorged block kept: %v", err)
+	}
+}
+
+func TestClientP2PUnknownPrevOuts(t *testing.T) {
+	peer := newStubPeer(t)
+	defer peer.listener.Close()
+	testP2PChain(peer)
+
+	// started after block 1 the taproot input of block 2 can't be resolved
+	_, err := peer.client(t, 2).GetTweaks(context.Background(), 2, 0)
+	if !errors.Is(err, src.ErrPrevOutUnknown) {
+		t.Errorf("expected ErrPrevOutUnknown, got %v", err)
+	}
+
+	// a p2wsh input which looks like p2wpkh is caught by the basic filter
+	_, pubKey := testSecKey(7)
+	prev := wire.OutPoint{Hash: chainhash.DoubleHashH([]byte("p2wsh")), Index: 0}
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&prev, nil, [][]byte{{0x01}, pubKey[:]}))
+	tx.AddTxOut(wire.NewTxOut(5000, taprootScript(pubKey)))
+	witnessScriptHash := chainhash.HashB(pubKey[:])
+	peer.prevOuts[prev] = append([]byte{0x00, 0x20}, witnessScriptHash...)
+	peer.mine(3, tx)
+
+	_, err = peer.client(t, 0).GetTweaks(context.Background(), 3, 0)
+	if !errors.Is(err, src.ErrPrevOutUnknown) {
+		t.Errorf("expected ErrPrevOutUnknown, got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package networking
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/proxy"
+)
+
+// NewTorIsolationAuth
+// returns random SOCKS5 credentials. Tor only shares a circuit between streams with the same credentials
+// (IsolateSOCKSAuth, enabled by default), so every set of credentials gets its own circuit.
+func NewTorIsolationAuth() (*proxy.Auth, error) {
+	var buf [32]byte
+	_, err := rand.Read(buf[:])
+	if err != nil {
+		return nil, err
+	}
+	return &proxy.Auth{User: hex.EncodeToString(buf[:16]), Password: hex.EncodeToString(buf[16:])}, nil
+}
+
+// NewIsolatedHttpClient
+// is like NewHttpClient but authenticates at the proxy with auth, which isolates its streams from all other traffic
+// through the same Tor instance. Nil auth uses no credentials.
+func NewIsolatedHttpClient(torProxyHost string, auth *proxy.Auth) *http.Client {
+	client := NewHttpClient(torProxyHost)
+	if torProxyHost != "" && auth != nil {
+		client.Transport.(*http.Transport).Proxy = http.ProxyURL(&url.URL{
+			Scheme: "socks5",
+			Host:   torProxyHost,
+			User:   url.UserPassword(auth.User, auth.Password),
+		})
+	}
+	return client
+}
+
+// NewTorDialer
+// returns a dial function which connects through the SOCKS5 proxy at torProxyHost.
+// Host names are passed to the proxy unresolved so that onion addresses work.
+func NewTorDialer(torProxyHost string, auth *proxy.Auth) (func(ctx context.Context, address string) (net.Conn, error), error) {
+	dialer, err := proxy.SOCKS5("tcp", torProxyHost, auth, proxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+	contextDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return nil, errors.New("socks5 dialer does not support contexts")
+	}
+	return func(ctx context.Context, address string) (net.Conn, error) {
+		return contextDialer.DialContext(ctx, "tcp", address)
+	}, nil
+}
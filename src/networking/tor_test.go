@@ -0,0 +1,117 @@
+package networking
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type socksRequest struct {
+	user, target string
+}
+
+// serveSocks5 accepts one connection, records the credentials and the target and answers a single http request
+func serveSocks5(t *testing.T, listener net.Listener, body string, requests chan<- socksRequest) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	// greeting, prefer username/password if offered
+	header := make([]byte, 2)
+	_, _ = io.ReadFull(reader, header)
+	methods := make([]byte, header[1])
+	_, _ = io.ReadFull(reader, methods)
+	method := byte(0x00)
+	if strings.ContainsRune(string(methods), 0x02) {
+		method = 0x02
+	}
+	_, _ = conn.Write([]byte{0x05, method})
+
+	var request socksRequest
+	if method == 0x02 {
+		_, _ = reader.ReadByte()
+		userLen, _ := reader.ReadByte()
+		user := make([]byte, userLen)
+		_, _ = io.ReadFull(reader, user)
+		passLen, _ := reader.ReadByte()
+		_, _ = io.ReadFull(reader, make([]byte, passLen))
+		request.user = string(user)
+		_, _ = conn.Write([]byte{0x01, 0x00})
+	}
+
+	// connect request, only domain names are expected
+	connect := make([]byte, 4)
+	_, _ = io.ReadFull(reader, connect)
+	if connect[3] != 0x03 {
+		t.Errorf("address was resolved locally, address type %d", connect[3])
+		return
+	}
+	hostLen, _ := reader.ReadByte()
+	host := make([]byte, hostLen)
+	_, _ = io.ReadFull(reader, host)
+	port := make([]byte, 2)
+	_, _ = io.ReadFull(reader, port)
+	request.target = net.JoinHostPort(string(host), strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+	_, _ = conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+	requests <- request
+
+	req, err := http.ReadRequest(reader)
+	if err != nil {
+		return
+	}
+	resp := &http.Response{
+		StatusCode:    http.StatusOK,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       req,
+		ContentLength: int64(len(body)),
+		Body:          io.NopCloser(strings.NewReader(body)),
+	}
+	_ = resp.Write(conn)
+}
+
+func TestClientBlindBitTor(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %v", err)
+	}
+	defer listener.Close()
+
+	requests := make(chan socksRequest, 1)
+	go serveSocks5(t, listener, `{"block_height":100}`, requests)
+
+	auth, err := NewTorIsolationAuth()
+	if err != nil {
+		t.Fatalf("error creating credentials: %v", err)
+	}
+	otherAuth, _ := NewTorIsolationAuth()
+	if auth.User == otherAuth.User {
+		t.Errorf("isolation credentials are not random")
+	}
+
+	client := &ClientBlindBit{
+		BaseUrl:    "http://indexerexampleaddress.onion:8000",
+		HttpClient: NewIsolatedHttpClient(listener.Addr().String(), auth),
+	}
+	tip, err := client.GetChainTip(context.Background())
+	if err != nil || tip != 100 {
+		t.Errorf("wrong chain tip %d: %v", tip, err)
+	}
+
+	request := <-requests
+	if request.target != "indexerexampleaddress.onion:8000" {
+		t.Errorf("wrong target %s", request.target)
+	}
+	if request.user != auth.User {
+		t.Errorf("isolation credentials were not sent, user %q", request.user)
+	}
+}
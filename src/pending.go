@@ -0,0 +1,210 @@
+package src
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
+)
+
+// PendingTransactions returns all outgoing transactions which are pending or were dropped
+func (w *Wallet) PendingTransactions() []*OutgoingTransaction {
+	var pending []*OutgoingTransaction
+	for _, tx := range w.OutgoingTransactions {
+		if tx.IsUnconfirmed() {
+			pending = append(pending, tx)
+		}
+	}
+	return pending
+}
+
+// UpdatePendingTransactions
+// sets pending and dropped transactions whose inputs were spent in a block to confirmed
+// and pending transactions which were first broadcast longer than dropTimeout ago to dropped.
+// Returns the transactions which were dropped by this call.
+func (w *Wallet) UpdatePendingTransactions(now time.Time, dropTimeout time.Duration) ([]*OutgoingTransaction, error) {
+	var dropped []*OutgoingTransaction
+	for _, tx := range w.PendingTransactions() {
+		inputs, err := w.outgoingTransactionInputs(tx)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if len(inputs) > 0 && countSpent(inputs) == len(inputs) {
+			tx.State = OutgoingTxConfirmed
+			continue
+		}
+		if tx.State == OutgoingTxPending && now.Sub(time.Unix(int64(tx.FirstBroadcast), 0)) > dropTimeout {
+			tx.State = OutgoingTxDropped
+			dropped = append(dropped, tx)
+		}
+	}
+	return dropped, nil
+}
+
+// AbandonTransaction
+// gives up on an unconfirmed outgoing transaction. Its inputs are set to unspent again
+// and its outputs to the wallet (e.g. change) are removed, if they were added already.
+// Transactions which were replaced can't be abandoned, abandon the replacement instead.
+func (w *Wallet) AbandonTransaction(txid string) error {
+	tx := w.GetOutgoingTransaction(txid)
+	if tx == nil {
+		return ErrTransactionNotFound
+	}
+	if tx.ReplacedBy != "" {
+		return fmt.Errorf("%w: replaced by %s, abandon the replacement instead", ErrTransactionNotAbandonable, tx.ReplacedBy)
+	}
+	if tx.State == OutgoingTxConfirmed || tx.State == OutgoingTxAbandoned {
+		return fmt.Errorf("%w: transaction is %s", ErrTransactionNotAbandonable, tx.State)
+	}
+
+	inputs, err := w.outgoingTransactionInputs(tx)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	if countSpent(inputs) > 0 {
+		tx.State = OutgoingTxConfirmed
+		return fmt.Errorf("%w: inputs were already spent in a block", ErrTransactionNotAbandonable)
+	}
+
+	for _, utxo := range inputs {
+		if utxo.State == StateUnconfirmedSpent {
+			utxo.State = StateUnspent
+		}
+	}
+
+	txidBytes, err := hex.DecodeString(tx.Txid)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return err
+	}
+	var remaining UtxoCollection
+	for _, utxo := range w.UTXOs {
+		if utxo.State == StateUnconfirmed && bytes.Equal(utxo.Txid[:], txidBytes) {
+			key, err := utxo.GetKey()
+			if err != nil {
+				logging.ErrorLogger.Println(err)
+				return err
+			}
+			delete(w.UTXOMapping, key)
+			continue
+		}
+		remaining = append(remaining, utxo)
+	}
+	w.UTXOs = remaining
+
+	tx.State = OutgoingTxAbandoned
+	return nil
+}
+
+// MarkReplacedTransactions
+// sets ReplacedBy on all unconfirmed outgoing transactions which spend an input of tx and returns them.
+// Called once tx is broadcast, the replaced transactions can't confirm anymore.
+func (w *Wallet) MarkReplacedTransactions(tx *wire.MsgTx) []*OutgoingTransaction {
+	txid := tx.TxHash().String()
+	spends := make(map[wire.OutPoint]struct{}, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		spends[txIn.PreviousOutPoint] = struct{}{}
+	}
+
+	var replaced []*OutgoingTransaction
+	for _, outgoingTx := range w.OutgoingTransactions {
+		if outgoingTx.Txid == txid || outgoingTx.ReplacedBy != "" ||
+			outgoingTx.State == OutgoingTxConfirmed || outgoingTx.State == OutgoingTxAbandoned {
+			continue
+		}
+		msgTx, err := outgoingTx.MsgTx()
+		if err != nil {
+			continue
+		}
+		for _, txIn := range msgTx.TxIn {
+			if _, ok := spends[txIn.PreviousOutPoint]; ok {
+				outgoingTx.ReplacedBy = txid
+				replaced = append(replaced, outgoingTx)
+				break
+			}
+		}
+	}
+	return replaced
+}
+
+// outgoingTransactionInputs returns the UTXOs of the wallet spent by tx
+func (w *Wallet) outgoingTransactionInputs(tx *OutgoingTransaction) (UtxoCollection, error) {
+	msgTx, err := tx.MsgTx()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	var inputs UtxoCollection
+	for _, txIn := range msgTx.TxIn {
+		outpoint, err := utils.SerialiseWireOutpoint(txIn.PreviousOutPoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		utxo, err := w.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if utxo != nil {
+			inputs = append(inputs, utxo)
+		}
+	}
+	return inputs, nil
+}
+
+func countSpent(utxos UtxoCollection) int {
+	var spent int
+	for _, utxo := range utxos {
+		if utxo.State == StateSpent {
+			spent++
+		}
+	}
+	return spent
+}
+
+// ReleaseTxInputs
+// sets the spent_unconfirmed UTXOs spent by tx back to unspent and returns them, e.g. for a transaction which was never broadcast.
+// UTXOs which are also spent by a pending or dropped outgoing transaction keep their state.
+func (w *Wallet) ReleaseTxInputs(tx *wire.MsgTx) (UtxoCollection, error) {
+	reserved := make(map[wire.OutPoint]struct{})
+	for _, outgoingTx := range w.PendingTransactions() {
+		msgTx, err := outgoingTx.MsgTx()
+		if err != nil {
+			continue
+		}
+		for _, txIn := range msgTx.TxIn {
+			reserved[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+
+	var released UtxoCollection
+	for _, txIn := range tx.TxIn {
+		if _, ok := reserved[txIn.PreviousOutPoint]; ok {
+			continue
+		}
+		outpoint, err := utils.SerialiseWireOutpoint(txIn.PreviousOutPoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		utxo, err := w.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if utxo == nil || utxo.State != StateUnconfirmedSpent {
+			continue
+		}
+		utxo.State = StateUnspent
+		released = append(released, utxo)
+	}
+	return released, nil
+}
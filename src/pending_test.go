@@ -0,0 +1,214 @@
+package src
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/go-bip352"
+)
+
+// newPendingTestWallet returns a wallet with one pending transaction spending both UTXOs and an unconfirmed change output
+func newPendingTestWallet(t *testing.T, broadcast time.Time) (*Wallet, *OutgoingTransaction) {
+	wallet := NewWallet(0)
+	wallet.UTXOMapping = UTXOMapping{}
+
+	var prevTxid [32]byte
+	prevTxid[0] = 0x01
+	err := wallet.AddUTXOs(UtxoCollection{
+		{Txid: prevTxid, Vout: 0, Amount: 10_000, State: StateUnconfirmedSpent},
+		{Txid: prevTxid, Vout: 1, Amount: 20_000, State: StateUnconfirmedSpent},
+	})
+	if err != nil {
+		t.Fatalf("error adding utxos: %v", err)
+	}
+
+	hash, err := chainhash.NewHash(bip352.ReverseBytesCopy(prevTxid[:]))
+	if err != nil {
+		t.Fatalf("error creating hash: %v", err)
+	}
+	msgTx := wire.NewMsgTx(2)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, 0), nil, nil))
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, 1), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(25_000, append([]byte{0x51, 0x20}, Empty32Arr[:]...)))
+	msgTx.AddTxOut(wire.NewTxOut(4_000, append([]byte{0x51, 0x20}, Empty32Arr[:]...)))
+
+	var buf bytes.Buffer
+	err = msgTx.Serialize(&buf)
+	if err != nil {
+		t.Fatalf("error serialising tx: %v", err)
+	}
+	txHash := msgTx.TxHash()
+
+	tx := &OutgoingTransaction{
+		Txid:       txHash.String(),
+		RawTx:      buf.Bytes(),
+		Recipients: []*Recipient{{Address: "sp1", Amount: 25_000}},
+	}
+	tx.MarkBroadcast(broadcast)
+	wallet.AddOutgoingTransaction(tx)
+
+	err = wallet.AddUTXOs(UtxoCollection{{
+		Txid:   bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(txHash[:])),
+		Vout:   1,
+		Amount: 4_000,
+		State:  StateUnconfirmed,
+	}})
+	if err != nil {
+		t.Fatalf("error adding change: %v", err)
+	}
+
+	return wallet, tx
+}
+
+func TestUpdatePendingTransactions(t *testing.T) {
+	now := time.Now()
+	wallet, tx := newPendingTestWallet(t, now.Add(-time.Hour))
+
+	dropped, err := wallet.UpdatePendingTransactions(now, 2*time.Hour)
+	if err != nil {
+		t.Errorf("error updating: %v", err)
+		return
+	}
+	if len(dropped) != 0 || tx.State != OutgoingTxPending {
+		t.Errorf("transaction dropped before the timeout: %s", tx.State)
+	}
+
+	dropped, err = wallet.UpdatePendingTransactions(now.Add(2*time.Hour), 2*time.Hour)
+	if err != nil {
+		t.Errorf("error updating: %v", err)
+		return
+	}
+	if len(dropped) != 1 || tx.State != OutgoingTxDropped {
+		t.Errorf("transaction not dropped after the timeout: %s", tx.State)
+	}
+
+	// dropped transactions can still confirm
+	for _, utxo := range wallet.UTXOs[:2] {
+		utxo.State = StateSpent
+	}
+	_, err = wallet.UpdatePendingTransactions(now.Add(2*time.Hour), 2*time.Hour)
+	if err != nil {
+		t.Errorf("error updating: %v", err)
+		return
+	}
+	if tx.State != OutgoingTxConfirmed || len(wallet.PendingTransactions()) != 0 {
+		t.Errorf("transaction not confirmed: %s", tx.State)
+	}
+}
+
+func TestAbandonTransaction(t *testing.T) {
+	wallet, tx := newPendingTestWallet(t, time.Now())
+
+	err := wallet.AbandonTransaction(tx.Txid)
+	if err != nil {
+		t.Errorf("error abandoning: %v", err)
+		return
+	}
+	if tx.State != OutgoingTxAbandoned {
+		t.Errorf("wrong state: %s", tx.State)
+	}
+	if len(wallet.UTXOs) != 2 || len(wallet.UTXOMapping) != 2 {
+		t.Errorf("change of the abandoned transaction was not removed")
+	}
+	for _, utxo := range wallet.UTXOs {
+		if utxo.State != StateUnspent {
+			t.Errorf("input was not returned to unspent: %d", utxo.State)
+		}
+	}
+	if wallet.SpentSince(time.Time{}, "") != 0 {
+		t.Errorf("abandoned transaction counted as spent")
+	}
+
+	err = wallet.AbandonTransaction(tx.Txid)
+	if !errors.Is(err, ErrTransactionNotAbandonable) {
+		t.Errorf("abandoned twice: %v", err)
+	}
+
+	confirmedWallet, confirmedTx := newPendingTestWallet(t, time.Now())
+	confirmedWallet.UTXOs[0].State = StateSpent
+	err = confirmedWallet.AbandonTransaction(confirmedTx.Txid)
+	if !errors.Is(err, ErrTransactionNotAbandonable) || confirmedWallet.UTXOs[1].State != StateUnconfirmedSpent {
+		t.Errorf("confirmed transaction was abandoned: %v", err)
+	}
+}
+
+func TestMarkReplacedTransactions(t *testing.T) {
+	wallet, tx := newPendingTestWallet(t, time.Now())
+	msgTx, err := tx.MsgTx()
+	if err != nil {
+		t.Fatalf("error parsing tx: %v", err)
+	}
+
+	// the transaction itself is not replaced by being broadcast again
+	if replaced := wallet.MarkReplacedTransactions(msgTx); len(replaced) != 0 {
+		t.Errorf("transaction replaced by itself")
+	}
+
+	// spends the second input only, with a higher fee
+	replacement := wire.NewMsgTx(2)
+	replacement.AddTxIn(wire.NewTxIn(&msgTx.TxIn[1].PreviousOutPoint, nil, nil))
+	replacement.AddTxOut(wire.NewTxOut(19_000, append([]byte{0x51, 0x20}, Empty32Arr[:]...)))
+	replacementHash := replacement.TxHash()
+
+	replaced := wallet.MarkReplacedTransactions(replacement)
+	if len(replaced) != 1 || replaced[0] != tx || tx.ReplacedBy != replacementHash.String() {
+		t.Errorf("transaction not marked as replaced: %q", tx.ReplacedBy)
+	}
+	if len(wallet.PendingTransactions()) != 0 {
+		t.Errorf("replaced transaction still pending")
+	}
+
+	unrelated := wire.NewMsgTx(2)
+	unrelated.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&replacementHash, 0), nil, nil))
+	abandonedWallet, abandonedTx := newPendingTestWallet(t, time.Now())
+	err = abandonedWallet.AbandonTransaction(abandonedTx.Txid)
+	if err != nil {
+		t.Fatalf("error abandoning: %v", err)
+	}
+	if len(abandonedWallet.MarkReplacedTransactions(unrelated)) != 0 || len(abandonedWallet.MarkReplacedTransactions(replacement)) != 0 {
+		t.Errorf("unrelated or abandoned transaction marked as replaced")
+	}
+}
+
+func TestReleaseTxInputs(t *testing.T) {
+	wallet, tx := newPendingTestWallet(t, time.Now())
+	msgTx, err := tx.MsgTx()
+	if err != nil {
+		t.Fatalf("error parsing tx: %v", err)
+	}
+
+	// both inputs are still reserved by the pending transaction
+	released, err := wallet.ReleaseTxInputs(msgTx)
+	if err != nil {
+		t.Fatalf("error releasing inputs: %v", err)
+	}
+	if len(released) != 0 {
+		t.Errorf("inputs of a pending transaction released: %d", len(released))
+	}
+
+	err = wallet.AbandonTransaction(tx.Txid)
+	if err != nil {
+		t.Fatalf("error abandoning: %v", err)
+	}
+	inputs, err := wallet.outgoingTransactionInputs(tx)
+	if err != nil {
+		t.Fatalf("error finding inputs: %v", err)
+	}
+	inputs[0].State = StateUnconfirmedSpent
+	inputs[1].State = StateSpent
+
+	released, err = wallet.ReleaseTxInputs(msgTx)
+	if err != nil {
+		t.Fatalf("error releasing inputs: %v", err)
+	}
+	if len(released) != 1 || released[0] != inputs[0] || inputs[0].State != StateUnspent {
+		t.Errorf("spent_unconfirmed input not released: %d", inputs[0].State)
+	}
+	if inputs[1].State != StateSpent {
+		t.Errorf("spent input changed: %d", inputs[1].State)
+	}
+}
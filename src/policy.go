@@ -0,0 +1,158 @@
+package src
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/spf13/viper"
+)
+
+// Policy
+// limits which are checked before the daemon signs a transaction. A value of 0 disables the limit.
+// Amounts are the sum of all recipients without change.
+type Policy struct {
+	MaxPerTransaction   uint64
+	DailyLimit          uint64 // rolling 24 hours
+	WeeklyLimit         uint64 // rolling 7 days
+	MaxFeeRateMilliSats uint64
+	Allowlist           map[string]struct{} // if not empty every recipient has to be in the allowlist
+}
+
+type PolicyRule string
+
+const (
+	PolicyRuleMaxPerTransaction PolicyRule = "max_per_transaction"
+	PolicyRuleDailyLimit        PolicyRule = "daily_limit"
+	PolicyRuleWeeklyLimit       PolicyRule = "weekly_limit"
+	PolicyRuleMaxFeeRate        PolicyRule = "max_fee_rate"
+	PolicyRuleAllowlist         PolicyRule = "allowlist"
+)
+
+// PolicyViolation
+// Limit and Actual are in sats, for PolicyRuleMaxFeeRate in millisats/vByte.
+// Address is only set for PolicyRuleAllowlist.
+type PolicyViolation struct {
+	Rule    PolicyRule
+	Limit   uint64
+	Actual  uint64
+	Address string
+}
+
+func (v *PolicyViolation) Error() string {
+	if v.Rule == PolicyRuleAllowlist {
+		return fmt.Sprintf("%s: %s is not allowed", v.Rule, v.Address)
+	}
+	return fmt.Sprintf("%s: %d exceeds limit %d", v.Rule, v.Actual, v.Limit)
+}
+
+func (v *PolicyViolation) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+// PolicyViolations collects all rules a transaction violates
+type PolicyViolations []*PolicyViolation
+
+func (v PolicyViolations) Error() string {
+	lines := make([]string, len(v))
+	for i, violation := range v {
+		lines[i] = violation.Error()
+	}
+	return fmt.Sprintf("%v:\n%s", ErrPolicyViolation, strings.Join(lines, "\n"))
+}
+
+func (v PolicyViolations) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+// LoadPolicy
+// reads the policy file (toml). The allowlist entries are checked against ChainParams, so it has to be set before.
+func LoadPolicy(path string) (*Policy, error) {
+	policyViper := viper.New()
+	policyViper.SetConfigFile(path)
+	err := policyViper.ReadInConfig()
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+
+	policy := &Policy{
+		MaxPerTransaction:   policyViper.GetUint64("limits.max_per_transaction"),
+		DailyLimit:          policyViper.GetUint64("limits.daily_limit"),
+		WeeklyLimit:         policyViper.GetUint64("limits.weekly_limit"),
+		MaxFeeRateMilliSats: uint64(math.Round(policyViper.GetFloat64("limits.max_fee_rate") * 1000)),
+		Allowlist:           make(map[string]struct{}),
+	}
+
+	for _, address := range policyViper.GetStringSlice("allowlist.addresses") {
+		_, err = recipientPkScript(address, ChainParams)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist address %s: %w", address, err)
+		}
+		policy.Allowlist[address] = struct{}{}
+	}
+
+	return policy, nil
+}
+
+// Check
+// returns PolicyViolations with every violated rule, nil if the transaction is allowed.
+// spentDay and spentWeek are the amounts already sent in the rolling windows.
+func (p *Policy) Check(recipients []*Recipient, feeRateMilliSats, spentDay, spentWeek uint64) error {
+	var violations PolicyViolations
+
+	var amount uint64
+	for _, recipient := range recipients {
+		amount += uint64(recipient.Amount)
+		if len(p.Allowlist) == 0 {
+			continue
+		}
+		if _, ok := p.Allowlist[recipient.Address]; !ok {
+			violations = append(violations, &PolicyViolation{Rule: PolicyRuleAllowlist, Address: recipient.Address})
+		}
+	}
+
+	for _, limit := range []struct {
+		rule   PolicyRule
+		limit  uint64
+		actual uint64
+	}{
+		{PolicyRuleMaxPerTransaction, p.MaxPerTransaction, amount},
+		{PolicyRuleDailyLimit, p.DailyLimit, spentDay + amount},
+		{PolicyRuleWeeklyLimit, p.WeeklyLimit, spentWeek + amount},
+		{PolicyRuleMaxFeeRate, p.MaxFeeRateMilliSats, feeRateMilliSats},
+	} {
+		if limit.limit > 0 && limit.actual > limit.limit {
+			violations = append(violations, &PolicyViolation{Rule: limit.rule, Limit: limit.limit, Actual: limit.actual})
+		}
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// SpentSince
+// sums the recipients of all broadcast outgoing transactions created after since.
+// Only pending, confirmed and dropped transactions are counted,
+// replaced ones and the transaction with txid excludeTxid (the one being replaced) are not.
+func (w *Wallet) SpentSince(since time.Time, excludeTxid string) uint64 {
+	var spent uint64
+	for _, tx := range w.OutgoingTransactions {
+		switch tx.State {
+		case OutgoingTxPending, OutgoingTxConfirmed, OutgoingTxDropped:
+		default:
+			continue
+		}
+		if tx.ReplacedBy != "" || tx.Txid == excludeTxid || int64(tx.Timestamp) < since.Unix() {
+			continue
+		}
+		for _, recipient := range tx.Recipients {
+			spent += uint64(recipient.Amount)
+		}
+	}
+	return spent
+}
@@ -0,0 +1,89 @@
+package src
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPolicy_Check(t *testing.T) {
+	allowed := "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"
+	policy := &Policy{
+		MaxPerTransaction:   100_000,
+		DailyLimit:          150_000,
+		WeeklyLimit:         500_000,
+		MaxFeeRateMilliSats: 20_000,
+		Allowlist:           map[string]struct{}{allowed: {}},
+	}
+
+	testCases := []struct {
+		name       string
+		recipients []*Recipient
+		feeRate    uint64
+		spentDay   uint64
+		spentWeek  uint64
+		rules      []PolicyRule
+	}{
+		{"allowed", []*Recipient{{Address: allowed, Amount: 50_000}}, 5_000, 0, 0, nil},
+		{"per transaction", []*Recipient{{Address: allowed, Amount: 60_000}, {Address: allowed, Amount: 60_000}}, 5_000, 0, 0, []PolicyRule{PolicyRuleMaxPerTransaction}},
+		{"daily", []*Recipient{{Address: allowed, Amount: 60_000}}, 5_000, 100_000, 100_000, []PolicyRule{PolicyRuleDailyLimit}},
+		{"weekly", []*Recipient{{Address: allowed, Amount: 60_000}}, 5_000, 0, 450_000, []PolicyRule{PolicyRuleWeeklyLimit}},
+		{"fee rate", []*Recipient{{Address: allowed, Amount: 1_000}}, 25_000, 0, 0, []PolicyRule{PolicyRuleMaxFeeRate}},
+		{"allowlist", []*Recipient{{Address: "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", Amount: 1_000}}, 5_000, 0, 0, []PolicyRule{PolicyRuleAllowlist}},
+		{"several", []*Recipient{{Address: "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu", Amount: 200_000}}, 25_000, 0, 0, []PolicyRule{PolicyRuleAllowlist, PolicyRuleMaxPerTransaction, PolicyRuleDailyLimit, PolicyRuleMaxFeeRate}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := policy.Check(testCase.recipients, testCase.feeRate, testCase.spentDay, testCase.spentWeek)
+			if testCase.rules == nil {
+				if err != nil {
+					t.Errorf("expected no violation, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrPolicyViolation) {
+				t.Errorf("expected policy violation, got %v", err)
+				return
+			}
+			var violations PolicyViolations
+			if !errors.As(err, &violations) {
+				t.Errorf("expected PolicyViolations, got %T", err)
+				return
+			}
+			if len(violations) != len(testCase.rules) {
+				t.Errorf("expected %d violations, got %v", len(testCase.rules), violations)
+				return
+			}
+			for i, rule := range testCase.rules {
+				if violations[i].Rule != rule {
+					t.Errorf("expected rule %s, got %s", rule, violations[i].Rule)
+				}
+			}
+		})
+	}
+}
+
+func TestWallet_SpentSince(t *testing.T) {
+	now := time.Now()
+	wallet := NewWallet(0)
+	wallet.OutgoingTransactions = []*OutgoingTransaction{
+		{Txid: "old", Timestamp: uint64(now.Add(-48 * time.Hour).Unix()), Recipients: []*Recipient{{Amount: 1_000}}, State: OutgoingTxConfirmed},
+		{Txid: "replaced", Timestamp: uint64(now.Add(-time.Hour).Unix()), Recipients: []*Recipient{{Amount: 2_000}}, State: OutgoingTxPending, ReplacedBy: "replacement"},
+		{Txid: "replacement", Timestamp: uint64(now.Add(-time.Hour).Unix()), Recipients: []*Recipient{{Amount: 2_000}}, State: OutgoingTxDropped, Replaces: "replaced"},
+		{Txid: "new", Timestamp: uint64(now.Unix()), Recipients: []*Recipient{{Amount: 3_000}, {Amount: 4_000}}, State: OutgoingTxPending},
+		// never broadcast or given up on, nothing left the wallet
+		{Txid: "created", Timestamp: uint64(now.Unix()), Recipients: []*Recipient{{Amount: 5_000}}, State: OutgoingTxCreated},
+		{Txid: "abandoned", Timestamp: uint64(now.Unix()), Recipients: []*Recipient{{Amount: 6_000}}, State: OutgoingTxAbandoned},
+	}
+
+	if spent := wallet.SpentSince(now.Add(-24*time.Hour), ""); spent != 9_000 {
+		t.Errorf("expected 9000 spent within 24h, got %d", spent)
+	}
+	if spent := wallet.SpentSince(now.Add(-7*24*time.Hour), ""); spent != 10_000 {
+		t.Errorf("expected 10000 spent within 7d, got %d", spent)
+	}
+	if spent := wallet.SpentSince(now.Add(-24*time.Hour), "new"); spent != 2_000 {
+		t.Errorf("expected 2000 spent without the excluded transaction, got %d", spent)
+	}
+}
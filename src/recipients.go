@@ -0,0 +1,136 @@
+package src
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src/utils"
+	"github.com/setavenger/go-bip352"
+)
+
+// RecipientError describes why the recipient in Row (starting at 1) can't be paid
+type RecipientError struct {
+	Row     int
+	Address string
+	Err     error
+}
+
+func (e *RecipientError) Error() string {
+	return fmt.Sprintf("row %d (%s): %v", e.Row, e.Address, e.Err)
+}
+
+func (e *RecipientError) Unwrap() error {
+	return e.Err
+}
+
+// RecipientErrors collects the errors of all invalid recipients
+type RecipientErrors []*RecipientError
+
+func (e RecipientErrors) Error() string {
+	lines := make([]string, len(e))
+	for i, recipientErr := range e {
+		lines[i] = recipientErr.Error()
+	}
+	return fmt.Sprintf("%d invalid recipients:\n%s", len(e), strings.Join(lines, "\n"))
+}
+
+// ValidateRecipients
+// checks every recipient before a transaction is built so that all invalid rows can be reported at once.
+// Returns RecipientErrors if at least one recipient is invalid.
+func ValidateRecipients(recipients []*Recipient, chainParams *chaincfg.Params) error {
+	var recipientErrors RecipientErrors
+	seen := make(map[string]int, len(recipients))
+
+	for i, recipient := range recipients {
+		row := i + 1
+		err := validateRecipient(recipient, chainParams)
+		if err == nil {
+			if firstRow, ok := seen[recipient.Address]; ok {
+				err = fmt.Errorf("%w: same address as row %d", ErrDuplicateRecipient, firstRow)
+			} else {
+				seen[recipient.Address] = row
+			}
+		}
+		if err != nil {
+			recipientErrors = append(recipientErrors, &RecipientError{Row: row, Address: recipient.Address, Err: err})
+		}
+	}
+
+	if len(recipientErrors) > 0 {
+		return recipientErrors
+	}
+	return nil
+}
+
+func validateRecipient(recipient *Recipient, chainParams *chaincfg.Params) error {
+	if recipient.Address == "" {
+		return ErrRecipientIncomplete
+	}
+	if recipient.Amount <= 0 {
+		return ErrRecipientAmountIsZero
+	}
+
+	pkScript, err := recipientPkScript(recipient.Address, chainParams)
+	if err != nil {
+		return err
+	}
+
+	dustThreshold := mempool.GetDustThreshold(wire.NewTxOut(recipient.Amount, pkScript))
+	if recipient.Amount < dustThreshold {
+		return fmt.Errorf("%w: %d sats is below %d sats", ErrAmountBelowDust, recipient.Amount, dustThreshold)
+	}
+
+	return nil
+}
+
+// recipientPkScript returns the script of the address.
+// For silent payment addresses a placeholder taproot script is returned as the final output is only known after coin selection.
+func recipientPkScript(address string, chainParams *chaincfg.Params) ([]byte, error) {
+	mainnet := chainParams.Name == chaincfg.MainNetParams.Name
+
+	if utils.IsSilentPaymentAddress(address) {
+		_, _, err := bip352.DecodeSilentPaymentAddressToKeys(address, mainnet)
+		if err != nil {
+			if err == bip352.AddressHRPError {
+				return nil, fmt.Errorf("%w: wallet runs on %s", ErrAddressNetworkMismatch, chainParams.Name)
+			}
+			return nil, err
+		}
+		return append([]byte{txscript.OP_1, txscript.OP_DATA_32}, Empty32Arr[:]...), nil
+	}
+
+	decoded, err := btcutil.DecodeAddress(address, chainParams)
+	if err != nil {
+		if network := findAddressNetwork(address); network != nil && network.Name != chainParams.Name {
+			return nil, fmt.Errorf("%w: address is for %s, wallet runs on %s", ErrAddressNetworkMismatch, network.Name, chainParams.Name)
+		}
+		return nil, err
+	}
+	if !decoded.IsForNet(chainParams) {
+		return nil, fmt.Errorf("%w: wallet runs on %s", ErrAddressNetworkMismatch, chainParams.Name)
+	}
+
+	return txscript.PayToAddrScript(decoded)
+}
+
+// findAddressNetwork returns the first network the address can be decoded for, nil if none.
+// Testnet and signet addresses can't be distinguished, testnet is returned for both.
+func findAddressNetwork(address string) *chaincfg.Params {
+	for _, params := range []*chaincfg.Params{
+		&chaincfg.MainNetParams,
+		&chaincfg.TestNet3Params,
+		&chaincfg.SigNetParams,
+		&chaincfg.RegressionNetParams,
+	} {
+		decoded, err := btcutil.DecodeAddress(address, params)
+		if err == nil && decoded.IsForNet(params) {
+			return params
+		}
+	}
+	return nil
+}
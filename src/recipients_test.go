@@ -0,0 +1,76 @@
+package src
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestValidateRecipients(t *testing.T) {
+	spAddress := "tsp1qqfqnnv8czppwysafq3uwgwvsc638hc8rx3hscuddh0xa2yd746s7xq36vuz08htp29hyml4u9shtlvcvqxuhjzldxjwyfnxmamz3ft8mh5tzx0hu"
+	taprootAddress, err := btcutil.NewAddressTaproot(Empty32Arr[:], &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Errorf("error creating address: %v", err)
+		return
+	}
+
+	recipients := []*Recipient{
+		{Address: spAddress, Amount: 10_000},
+		{Address: taprootAddress.EncodeAddress(), Amount: 10_000},
+		{Address: "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr", Amount: 10_000},
+		{Address: "sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc9pkqwv", Amount: 10_000},
+		{Address: spAddress, Amount: 10_000},
+		{Address: "tb1qnotanaddress", Amount: 10_000},
+		{Address: taprootAddress.EncodeAddress(), Amount: 100},
+		{Address: "", Amount: 10_000},
+		{Address: spAddress, Amount: 0},
+	}
+
+	targets := []error{
+		nil,
+		nil,
+		ErrAddressNetworkMismatch,
+		ErrAddressNetworkMismatch,
+		ErrDuplicateRecipient,
+		nil, // decoding error
+		ErrAmountBelowDust,
+		ErrRecipientIncomplete,
+		ErrRecipientAmountIsZero,
+	}
+
+	err = ValidateRecipients(recipients, &chaincfg.TestNet3Params)
+	var recipientErrors RecipientErrors
+	if !errors.As(err, &recipientErrors) {
+		t.Errorf("expected RecipientErrors, got %v", err)
+		return
+	}
+
+	byRow := make(map[int]*RecipientError)
+	for _, recipientErr := range recipientErrors {
+		byRow[recipientErr.Row] = recipientErr
+	}
+
+	for i, target := range targets {
+		recipientErr, ok := byRow[i+1]
+		if i < 2 {
+			if ok {
+				t.Errorf("row %d should be valid, got %v", i+1, recipientErr)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("row %d should be invalid", i+1)
+			continue
+		}
+		if target != nil && !errors.Is(recipientErr, target) {
+			t.Errorf("row %d: expected %v, got %v", i+1, target, recipientErr.Err)
+		}
+	}
+
+	err = ValidateRecipients(recipients[:2], &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Errorf("valid recipients were rejected: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package src
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Schedule
+// a send which the daemon executes at a block height or time and optionally repeats.
+// Height based schedules use StartHeight/IntervalBlocks, time based schedules use StartTime/Interval/IntervalMonths.
+type Schedule struct {
+	Id         string       `json:"id"`
+	Recipients []*Recipient `json:"recipients"`
+	Comment    string       `json:"comment,omitempty"`
+
+	StartHeight    uint64        `json:"start_height,omitempty"`
+	StartTime      time.Time     `json:"start_time,omitempty"`
+	IntervalBlocks uint64        `json:"interval_blocks,omitempty"`
+	Interval       time.Duration `json:"interval,omitempty"`
+	IntervalMonths uint32        `json:"interval_months,omitempty"`
+
+	FeeRateMilliSats    uint64 `json:"fee_rate_milli_sats,omitempty"`
+	ConfTarget          uint32 `json:"conf_target,omitempty"`
+	MaxFeeRateMilliSats uint64 `json:"max_fee_rate_milli_sats,omitempty"` // 0 = no cap
+	MaxFee              uint64 `json:"max_fee,omitempty"`                 // 0 = no cap
+
+	Active      bool      `json:"active"`
+	NextHeight  uint64    `json:"next_height,omitempty"`
+	NextTime    time.Time `json:"next_time,omitempty"`
+	RetryHeight uint64    `json:"retry_height,omitempty"` // failed executions are retried once the wallet reached this height
+
+	Executions []*ScheduleExecution `json:"executions,omitempty"`
+}
+
+// ScheduleExecution is an entry in the audit log of a Schedule
+type ScheduleExecution struct {
+	Timestamp        time.Time `json:"timestamp"`
+	Height           uint64    `json:"height"`
+	Txid             string    `json:"txid,omitempty"`
+	Fee              uint64    `json:"fee,omitempty"`
+	FeeRateMilliSats uint64    `json:"fee_rate_milli_sats,omitempty"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// NewSchedule checks the timing and fee settings and sets the first execution
+func NewSchedule(schedule *Schedule) (*Schedule, error) {
+	isHeight := schedule.StartHeight > 0
+	isTime := !schedule.StartTime.IsZero()
+
+	switch {
+	case isHeight == isTime:
+		return nil, ErrInvalidScheduleTiming
+	case isHeight && (schedule.Interval > 0 || schedule.IntervalMonths > 0):
+		return nil, ErrInvalidScheduleTiming
+	case isTime && schedule.IntervalBlocks > 0:
+		return nil, ErrInvalidScheduleTiming
+	case schedule.Interval > 0 && schedule.IntervalMonths > 0:
+		return nil, ErrInvalidScheduleTiming
+	}
+
+	if schedule.FeeRateMilliSats == 0 && schedule.ConfTarget == 0 {
+		return nil, ErrInvalidFeeRate
+	}
+	if len(schedule.Recipients) == 0 {
+		return nil, ErrRecipientIncomplete
+	}
+
+	id := make([]byte, 8)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.Id = hex.EncodeToString(id)
+	schedule.Active = true
+	schedule.NextHeight = schedule.StartHeight
+	schedule.NextTime = schedule.StartTime
+	schedule.Executions = nil
+
+	return schedule, nil
+}
+
+// IsRecurring is false for schedules which are only executed once
+func (s *Schedule) IsRecurring() bool {
+	return s.IntervalBlocks > 0 || s.Interval > 0 || s.IntervalMonths > 0
+}
+
+// IsDue returns whether the schedule has to be executed at height and now
+func (s *Schedule) IsDue(height uint64, now time.Time) bool {
+	if !s.Active || height < s.RetryHeight {
+		return false
+	}
+	if s.NextHeight > 0 {
+		return height >= s.NextHeight
+	}
+	return !now.Before(s.NextTime)
+}
+
+// Advance
+// moves the next execution past height and now, executions which were missed in the meantime are skipped.
+// One-off schedules become inactive.
+func (s *Schedule) Advance(height uint64, now time.Time) {
+	s.RetryHeight = 0
+	if !s.IsRecurring() {
+		s.Active = false
+		return
+	}
+
+	if s.NextHeight > 0 {
+		for s.NextHeight <= height {
+			s.NextHeight += s.IntervalBlocks
+		}
+		return
+	}
+
+	if s.IntervalMonths > 0 {
+		// always count from the start, otherwise a start on the 31st would drift to earlier days after short months
+		for months := int(s.IntervalMonths); !s.NextTime.After(now); months += int(s.IntervalMonths) {
+			s.NextTime = s.StartTime.AddDate(0, months, 0)
+		}
+		return
+	}
+
+	for !s.NextTime.After(now) {
+		s.NextTime = s.NextTime.Add(s.Interval)
+	}
+}
+
+// ScheduleStore holds all schedules of the wallet and is persisted next to the wallet
+type ScheduleStore struct {
+	Schedules []*Schedule `json:"schedules"`
+}
+
+func (s *ScheduleStore) Serialise() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func (s *ScheduleStore) DeSerialise(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+// Add appends the schedule
+func (s *ScheduleStore) Add(schedule *Schedule) {
+	s.Schedules = append(s.Schedules, schedule)
+}
+
+// Delete removes the schedule with id, returns ErrScheduleNotFound if there is none
+func (s *ScheduleStore) Delete(id string) error {
+	for i, schedule := range s.Schedules {
+		if schedule.Id == id {
+			s.Schedules = append(s.Schedules[:i], s.Schedules[i+1:]...)
+			return nil
+		}
+	}
+	return ErrScheduleNotFound
+}
+
+// Due returns all schedules which have to be executed at height and now
+func (s *ScheduleStore) Due(height uint64, now time.Time) []*Schedule {
+	var due []*Schedule
+	for _, schedule := range s.Schedules {
+		if schedule.IsDue(height, now) {
+			due = append(due, schedule)
+		}
+	}
+	return due
+}
@@ -0,0 +1,93 @@
+package src
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewSchedule(t *testing.T) {
+	recipients := []*Recipient{{Address: "sp1", Amount: 10_000}}
+	start := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		schedule Schedule
+		err      error
+	}{
+		{"height", Schedule{Recipients: recipients, StartHeight: 800_000, IntervalBlocks: 144, FeeRateMilliSats: 2_000}, nil},
+		{"time", Schedule{Recipients: recipients, StartTime: start, IntervalMonths: 1, ConfTarget: 6}, nil},
+		{"no start", Schedule{Recipients: recipients, FeeRateMilliSats: 2_000}, ErrInvalidScheduleTiming},
+		{"height and time", Schedule{Recipients: recipients, StartHeight: 800_000, StartTime: start, FeeRateMilliSats: 2_000}, ErrInvalidScheduleTiming},
+		{"height with time interval", Schedule{Recipients: recipients, StartHeight: 800_000, Interval: time.Hour, FeeRateMilliSats: 2_000}, ErrInvalidScheduleTiming},
+		{"time with block interval", Schedule{Recipients: recipients, StartTime: start, IntervalBlocks: 144, FeeRateMilliSats: 2_000}, ErrInvalidScheduleTiming},
+		{"no fee", Schedule{Recipients: recipients, StartHeight: 800_000}, ErrInvalidFeeRate},
+		{"no recipients", Schedule{StartHeight: 800_000, FeeRateMilliSats: 2_000}, ErrRecipientIncomplete},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			schedule, err := NewSchedule(&testCase.schedule)
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("expected %v, got %v", testCase.err, err)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if schedule.Id == "" || !schedule.Active {
+				t.Errorf("schedule was not initialised: %+v", schedule)
+			}
+		})
+	}
+}
+
+func TestSchedule_Advance(t *testing.T) {
+	recipients := []*Recipient{{Address: "sp1", Amount: 10_000}}
+
+	blocks, err := NewSchedule(&Schedule{Recipients: recipients, StartHeight: 800_000, IntervalBlocks: 144, FeeRateMilliSats: 2_000})
+	if err != nil {
+		t.Errorf("error creating schedule: %v", err)
+		return
+	}
+	if blocks.IsDue(799_999, time.Now()) || !blocks.IsDue(800_000, time.Now()) {
+		t.Errorf("height schedule is due at the wrong height")
+		return
+	}
+	// executions missed while the daemon was offline are skipped
+	blocks.Advance(800_300, time.Now())
+	if blocks.NextHeight != 800_432 {
+		t.Errorf("expected next height 800432, got %d", blocks.NextHeight)
+	}
+
+	// failed executions wait for the retry height
+	blocks.RetryHeight = 800_433
+	if blocks.IsDue(800_432, time.Now()) {
+		t.Errorf("schedule should wait for the retry height")
+	}
+
+	start := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	monthly, err := NewSchedule(&Schedule{Recipients: recipients, StartTime: start, IntervalMonths: 1, FeeRateMilliSats: 2_000})
+	if err != nil {
+		t.Errorf("error creating schedule: %v", err)
+		return
+	}
+	if monthly.IsDue(0, start.Add(-time.Second)) || !monthly.IsDue(0, start) {
+		t.Errorf("time schedule is due at the wrong time")
+		return
+	}
+	monthly.Advance(0, start.AddDate(0, 2, 5))
+	if !monthly.NextTime.Equal(start.AddDate(0, 3, 0)) {
+		t.Errorf("expected next time %s, got %s", start.AddDate(0, 3, 0), monthly.NextTime)
+	}
+
+	once, err := NewSchedule(&Schedule{Recipients: recipients, StartHeight: 800_000, FeeRateMilliSats: 2_000})
+	if err != nil {
+		t.Errorf("error creating schedule: %v", err)
+		return
+	}
+	once.Advance(800_000, time.Now())
+	if once.Active || once.IsDue(800_001, time.Now()) {
+		t.Errorf("one-off schedule is still active")
+	}
+}
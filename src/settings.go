@@ -1,6 +1,8 @@
 package src
 
 import (
+	"net"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -11,6 +13,19 @@ import (
 
 const DefaultDirectoryPath = "~/.blindbitd"
 
+const (
+	IndexerProtocolHTTP = "http"
+	// IndexerProtocolGRPC is not offered in the config until the client is generated from the BlindBit-Protos definitions
+	IndexerProtocolGRPC = "grpc"
+	// IndexerProtocolBitcoind computes the indexer data locally from the blocks of a Bitcoin Core node
+	IndexerProtocolBitcoind = "bitcoind"
+	// IndexerProtocolP2P computes the indexer data locally from blocks and compact filters of a P2P peer
+	IndexerProtocolP2P = "p2p"
+)
+
+// TaprootActivationHeightMainnet taproot outputs can only be spent with a key from this height on
+const TaprootActivationHeightMainnet = 709632
+
 var (
 	DirectoryPath = "~/.blindbitd"
 
@@ -23,7 +38,13 @@ var (
 
 	PathDbWallet string
 
+	PathDbSchedules string
+
 	PathToKeys string
+
+	PathIndexerCache string
+
+	PathP2PState string
 )
 
 const PathEndingSocketDirPath = "/run"
@@ -36,8 +57,14 @@ const PathEndingConfig = "/blindbit.toml"
 
 const PathEndingWallet = dataPath + "/wallet"
 
+const PathEndingSchedules = dataPath + "/schedules"
+
 const PathEndingKeys = dataPath + "/keys"
 
+const PathEndingIndexerCache = dataPath + "/indexer-cache"
+
+const PathEndingP2PState = dataPath + "/p2p-state"
+
 func SetPaths(baseDirectory string) {
 	if baseDirectory != "" {
 		DirectoryPath = baseDirectory
@@ -52,9 +79,14 @@ func SetPaths(baseDirectory string) {
 
 	PathConfig = DirectoryPath + PathEndingConfig
 	PathDbWallet = DirectoryPath + PathEndingWallet
+	PathDbSchedules = DirectoryPath + PathEndingSchedules
 
 	PathToKeys = DirectoryPath + PathEndingKeys
 
+	PathIndexerCache = DirectoryPath + PathEndingIndexerCache
+
+	PathP2PState = DirectoryPath + PathEndingP2PState
+
 	// create the directories
 	utils.TryCreateDirectoryPanic(DirectoryPath)
 	utils.TryCreateDirectoryPanic(PathIpcSocketDir)
@@ -75,19 +107,88 @@ func LoadConfigs(pathToConfig string) {
 	/* set defaults */
 	// network
 	viper.SetDefault("network.blindbit_server", "http://localhost:8000")
+	viper.SetDefault("network.blindbit_servers", []string{})
+	viper.SetDefault("network.indexer_max_lag", 2)
+	viper.SetDefault("network.indexer_cross_check", false)
+	viper.SetDefault("network.tweak_source", TweakSourceAuto)
+	viper.SetDefault("network.tweak_full_index_depth", 1008)
+	viper.SetDefault("network.indexer_cache", false)
+	viper.SetDefault("network.indexer_cache_size_mb", 1024)
+	viper.SetDefault("network.indexer_protocol", IndexerProtocolHTTP)
+	viper.SetDefault("network.bitcoind_rpc_url", "http://127.0.0.1:8332")
+	viper.SetDefault("network.bitcoind_rpc_user", "")
+	viper.SetDefault("network.bitcoind_rpc_password", "")
+	viper.SetDefault("network.bitcoind_rpc_cookie", "")
+	viper.SetDefault("network.p2p_peer", "")
+	viper.SetDefault("network.p2p_start_height", -1)
+	viper.SetDefault("network.blindbit_tor", false)
+	viper.SetDefault("network.blindbit_tor_proxy_host", "127.0.0.1:9050")
+	viper.SetDefault("network.blindbit_tor_isolation", true)
 	viper.SetDefault("network.electrum_server", "") // we set this to empty
+	viper.SetDefault("network.electrum_servers", []string{})
+	viper.SetDefault("network.electrum_ping_interval", "30s")
+	viper.SetDefault("network.electrum_require_tls", false)
 	viper.SetDefault("network.chain", "signet")
 	viper.SetDefault("network.electrum_tor", true)
 	viper.SetDefault("network.electrum_tor_proxy_host", "127.0.0.1:9050")
+	viper.SetDefault("network.indexer_fee_estimates", false)
 
 	// wallet
 	viper.SetDefault("wallet.minchange_amount", 1000)
 	viper.SetDefault("wallet.dust_limit", 1000)
+	viper.SetDefault("wallet.policy_file", "")
+	viper.SetDefault("wallet.rebroadcast_interval", "30m")
+	viper.SetDefault("wallet.drop_timeout", "72h")
 
 	/* read and set config variables */
-	BlindBitServerAddress = viper.GetString("network.blindbit_server")
-	ElectrumServerAddress = viper.GetString("network.electrum_server")
-	if ElectrumServerAddress != "" {
+	BlindBitServerAddresses = viper.GetStringSlice("network.blindbit_servers")
+	if len(BlindBitServerAddresses) == 0 {
+		BlindBitServerAddresses = []string{viper.GetString("network.blindbit_server")}
+	}
+	BlindBitServerAddress = BlindBitServerAddresses[0]
+	BitcoindRpcUrl = viper.GetString("network.bitcoind_rpc_url")
+	BitcoindRpcUser = viper.GetString("network.bitcoind_rpc_user")
+	BitcoindRpcPassword = viper.GetString("network.bitcoind_rpc_password")
+	BitcoindRpcCookie = utils.ResolvePath(viper.GetString("network.bitcoind_rpc_cookie"))
+	IndexerMaxLag = viper.GetUint64("network.indexer_max_lag")
+	IndexerCrossCheck = viper.GetBool("network.indexer_cross_check")
+	if IndexerCrossCheck && len(BlindBitServerAddresses) < 2 {
+		logging.ErrorLogger.Fatalln("Error reading config file, indexer_cross_check needs at least two blindbit_servers")
+	}
+	IndexerProtocol = viper.GetString("network.indexer_protocol")
+	if IndexerProtocol == IndexerProtocolGRPC {
+		logging.ErrorLogger.Fatalln("Error reading config file, indexer protocol grpc is not supported yet, use http")
+	}
+	if IndexerProtocol != IndexerProtocolHTTP && IndexerProtocol != IndexerProtocolBitcoind && IndexerProtocol != IndexerProtocolP2P {
+		logging.ErrorLogger.Fatalf("Error reading config file, invalid indexer protocol: %s", IndexerProtocol)
+	}
+	if viper.GetBool("network.blindbit_tor") {
+		BlindBitTorProxyHost = viper.GetString("network.blindbit_tor_proxy_host")
+	} else {
+		BlindBitTorProxyHost = ""
+		for _, address := range BlindBitServerAddresses {
+			if isOnionAddress(address) {
+				logging.ErrorLogger.Fatalf("Error reading config file, %s is an onion address but blindbit_tor is disabled", address)
+			}
+		}
+	}
+	BlindBitTorIsolation = viper.GetBool("network.blindbit_tor_isolation")
+	TweakSource = viper.GetString("network.tweak_source")
+	if TweakSource != TweakSourceAuto && TweakSource != TweakSourceFull && TweakSource != TweakSourceCutThrough {
+		logging.ErrorLogger.Fatalf("Error reading config file, invalid tweak source: %s", TweakSource)
+	}
+	TweakFullIndexDepth = viper.GetUint64("network.tweak_full_index_depth")
+	UseIndexerCache = viper.GetBool("network.indexer_cache")
+	IndexerCacheMaxSize = viper.GetInt64("network.indexer_cache_size_mb") << 20
+	electrumEndpoints := viper.GetStringSlice("network.electrum_servers")
+	if len(electrumEndpoints) == 0 && viper.GetString("network.electrum_server") != "" {
+		electrumEndpoints = []string{viper.GetString("network.electrum_server")}
+	}
+	ElectrumPingInterval = viper.GetDuration("network.electrum_ping_interval")
+	if ElectrumPingInterval <= 0 {
+		logging.ErrorLogger.Fatalln("Error reading config file, electrum_ping_interval has to be positive")
+	}
+	if len(electrumEndpoints) > 0 {
 		UseElectrum = true
 		useTor := viper.GetBool("network.electrum_tor")
 		if useTor {
@@ -96,13 +197,22 @@ func LoadConfigs(pathToConfig string) {
 			// we set the host to empty which results in no tor being used
 			ElectrumTorProxyHost = ""
 		}
+		servers, err := loadElectrumServers(electrumEndpoints, ElectrumTorProxyHost, viper.GetBool("network.electrum_require_tls"))
+		if err != nil {
+			logging.ErrorLogger.Fatalf("Error reading config file, %s", err)
+		}
+		ElectrumServers = servers
 	} else {
 		UseElectrum = false
 		AutomaticScanInterval = 1 * time.Minute
 	}
 
+	UseIndexerFeeEstimates = viper.GetBool("network.indexer_fee_estimates")
+
 	MinChangeAmount = viper.GetInt64("wallet.minchange_amount")
 	DustLimit = viper.GetUint64("wallet.dust_limit")
+	RebroadcastInterval = viper.GetDuration("wallet.rebroadcast_interval")
+	DropTimeout = viper.GetDuration("wallet.drop_timeout")
 
 	// extract the chain data and set the params
 	chain := viper.GetString("network.chain")
@@ -118,4 +228,44 @@ func LoadConfigs(pathToConfig string) {
 	default:
 		logging.ErrorLogger.Fatalf("Error reading config file, invalid chain: %s", chain)
 	}
+
+	P2PPeerAddress = viper.GetString("network.p2p_peer")
+	if P2PPeerAddress == "" {
+		P2PPeerAddress = net.JoinHostPort("127.0.0.1", ChainParams.DefaultPort)
+	}
+	if startHeight := viper.GetInt64("network.p2p_start_height"); startHeight >= 0 {
+		P2PStartHeight = uint64(startHeight)
+	} else if ChainParams.Name == chaincfg.MainNetParams.Name {
+		P2PStartHeight = TaprootActivationHeightMainnet
+	} else {
+		P2PStartHeight = 0
+	}
+
+	backends, err := loadBroadcastBackends()
+	if err != nil {
+		logging.ErrorLogger.Fatalf("Error reading config file, %s", err)
+	}
+	BroadcastBackends = backends
+
+	// the policy needs the chain params to check the allowlist
+	policyFile := viper.GetString("wallet.policy_file")
+	if policyFile != "" {
+		policy, err := LoadPolicy(utils.ResolvePath(policyFile))
+		if err != nil {
+			logging.ErrorLogger.Fatalf("Error reading policy file, %s", err)
+		}
+		SpendingPolicy = policy
+	}
+}
+
+// isOnionAddress checks the host of a url (http://host:port/path) or a gRPC address (host:port)
+func isOnionAddress(address string) bool {
+	if _, rest, found := strings.Cut(address, "://"); found {
+		address = rest
+	}
+	host, _, _ := strings.Cut(address, "/")
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.HasSuffix(strings.ToLower(host), ".onion")
 }
@@ -0,0 +1,98 @@
+package src
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/blindbitd/src/logging"
+)
+
+// OutgoingTransaction
+// a transaction created by this wallet. Recipients are stored as requested by the user (without change)
+// such that the transaction can be rebuilt with a different set of inputs (SP outputs depend on the inputs).
+type OutgoingTransaction struct {
+	Txid             string       `json:"txid"`
+	RawTx            []byte       `json:"raw_tx"`
+	Recipients       []*Recipient `json:"recipients"`
+	Fee              uint64       `json:"fee"`
+	FeeRateMilliSats uint64       `json:"fee_rate_milli_sats"`
+	VSize            int64        `json:"vsize"`
+	Timestamp        uint64       `json:"timestamp"`
+	Replaces         string       `json:"replaces,omitempty"`    // txid of the transaction this one replaced via RBF
+	ReplacedBy       string       `json:"replaced_by,omitempty"` // txid of the transaction that replaced this one via RBF
+
+	State          OutgoingTxState `json:"state,omitempty"`
+	FirstBroadcast uint64          `json:"first_broadcast,omitempty"` // unix timestamp, 0 if never broadcast by the daemon
+	LastBroadcast  uint64          `json:"last_broadcast,omitempty"`
+	External       bool            `json:"external,omitempty"` // signed elsewhere and broadcast as raw tx, only tracked and can't be rebuilt
+}
+
+// OutgoingTxState
+// tracks whether an outgoing transaction made it into a block
+type OutgoingTxState int8
+
+const (
+	OutgoingTxCreated   OutgoingTxState = iota // not broadcast by the daemon (yet)
+	OutgoingTxPending                          // broadcast and waiting for confirmation, rebroadcast periodically
+	OutgoingTxConfirmed                        // all inputs were spent in a block, by this or a conflicting transaction
+	OutgoingTxDropped                          // pending for longer than DropTimeout, not rebroadcast anymore
+	OutgoingTxAbandoned                        // abandoned by the user, the inputs were returned to unspent
+)
+
+func (s OutgoingTxState) String() string {
+	switch s {
+	case OutgoingTxCreated:
+		return "created"
+	case OutgoingTxPending:
+		return "pending"
+	case OutgoingTxConfirmed:
+		return "confirmed"
+	case OutgoingTxDropped:
+		return "dropped"
+	case OutgoingTxAbandoned:
+		return "abandoned"
+	default:
+		return "unknown"
+	}
+}
+
+// IsUnconfirmed is true for transactions which might still confirm and keep their inputs locked
+func (t *OutgoingTransaction) IsUnconfirmed() bool {
+	return t.ReplacedBy == "" && (t.State == OutgoingTxPending || t.State == OutgoingTxDropped)
+}
+
+// MarkBroadcast sets the transaction to pending. A dropped transaction which is broadcast again starts a new timeout.
+func (t *OutgoingTransaction) MarkBroadcast(now time.Time) {
+	if t.State != OutgoingTxPending {
+		t.FirstBroadcast = uint64(now.Unix())
+	}
+	t.State = OutgoingTxPending
+	t.LastBroadcast = uint64(now.Unix())
+}
+
+// MsgTx decodes the stored raw transaction
+func (t *OutgoingTransaction) MsgTx() (*wire.MsgTx, error) {
+	var msgTx wire.MsgTx
+	err := msgTx.Deserialize(bytes.NewReader(t.RawTx))
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return nil, err
+	}
+	return &msgTx, nil
+}
+
+// CopyRecipientsForRebuild
+// returns fresh copies of the recipients without the computed PkScripts.
+// Needed whenever a transaction is rebuilt, as the SP outputs change with the inputs.
+func CopyRecipientsForRebuild(recipients []*Recipient) []*Recipient {
+	result := make([]*Recipient, len(recipients))
+	for i, recipient := range recipients {
+		result[i] = &Recipient{
+			Address:    recipient.Address,
+			Amount:     recipient.Amount,
+			Annotation: recipient.Annotation,
+		}
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+package src
+
+const (
+	// TweakSourceAuto uses the full tweak index for recent blocks and cut-through tweaks for older blocks
+	TweakSourceAuto       = "auto"
+	TweakSourceFull       = "full"
+	TweakSourceCutThrough = "cut-through"
+)
+
+// UseCutThroughTweaks
+// decides whether blockHeight is scanned with the cut-through tweaks instead of the full tweak index.
+// Cut-through leaves out transactions whose taproot outputs are all spent. Payments which were received and
+// spent again are not found with it, which does not change the balance but leaves gaps in the history.
+// In auto mode only blocks more than TweakFullIndexDepth below the tip use cut-through. The normal sync
+// near the tip stays complete, while a recovery of an old wallet downloads far fewer tweaks.
+func UseCutThroughTweaks(blockHeight, chainTip uint64) bool {
+	switch TweakSource {
+	case TweakSourceFull:
+		return false
+	case TweakSourceCutThrough:
+		return true
+	default:
+		return blockHeight+TweakFullIndexDepth < chainTip
+	}
+}
@@ -0,0 +1,36 @@
+package src
+
+import "testing"
+
+func TestUseCutThroughTweaks(t *testing.T) {
+	defer func(source string, depth uint64) {
+		TweakSource, TweakFullIndexDepth = source, depth
+	}(TweakSource, TweakFullIndexDepth)
+
+	TweakSource, TweakFullIndexDepth = TweakSourceAuto, 100
+	tests := []struct {
+		blockHeight, chainTip uint64
+		want                  bool
+	}{
+		{blockHeight: 1000, chainTip: 1000, want: false},
+		{blockHeight: 900, chainTip: 1000, want: false},
+		{blockHeight: 899, chainTip: 1000, want: true},
+		{blockHeight: 1, chainTip: 1000, want: true},
+		// nothing is deep enough on a young chain
+		{blockHeight: 1, chainTip: 50, want: false},
+	}
+	for _, test := range tests {
+		if got := UseCutThroughTweaks(test.blockHeight, test.chainTip); got != test.want {
+			t.Errorf("block %d with tip %d: got %t", test.blockHeight, test.chainTip, got)
+		}
+	}
+
+	TweakSource = TweakSourceFull
+	if UseCutThroughTweaks(1, 1000) {
+		t.Errorf("full mode used cut-through")
+	}
+	TweakSource = TweakSourceCutThrough
+	if !UseCutThroughTweaks(1000, 1000) {
+		t.Errorf("cut-through mode used the full index")
+	}
+}
@@ -24,11 +24,36 @@ const (
 )
 
 type Recipient struct {
-	Address    string
-	PkScript   []byte
-	Amount     int64
-	Annotation string
-	Data       map[string]any
+	Address    string         `json:"address"`
+	PkScript   []byte         `json:"pk_script,omitempty"`
+	Amount     int64          `json:"amount"`
+	Annotation string         `json:"annotation,omitempty"`
+	Data       map[string]any `json:"data,omitempty"`
+}
+
+// FeeTarget defines which fee a transaction should pay.
+// If AbsoluteFee is set it takes precedence and the transaction pays exactly that amount of sats.
+// Otherwise, the fee is computed from FeeRateMilliSats (millisats/vByte, 1_500 = 1.5 sats/vByte).
+// If AncestorVSize is set, FeeRateMilliSats is the target for the package of the transaction
+// and its unconfirmed ancestors which paid AncestorFee in total (CPFP).
+type FeeTarget struct {
+	FeeRateMilliSats uint64
+	AbsoluteFee      uint64
+	AncestorVSize    uint64
+	AncestorFee      uint64
+}
+
+// FeeRate returns the fee rate in sats/vByte
+func (f FeeTarget) FeeRate() float64 {
+	return float64(f.FeeRateMilliSats) / 1000
+}
+
+func (f FeeTarget) IsAbsolute() bool {
+	return f.AbsoluteFee > 0
+}
+
+func (f FeeTarget) IsPackage() bool {
+	return !f.IsAbsolute() && f.AncestorVSize > 0
 }
 
 type Label struct {
@@ -0,0 +1,188 @@
+package src
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+const paymentURIScheme = "bitcoin:"
+
+// PaymentURI
+// a parsed BIP 21 URI. SilentPaymentAddress is taken from the `sp` parameter,
+// Address is the (fallback) on-chain address in the path and might be empty if an SP address is given.
+type PaymentURI struct {
+	Address              string
+	SilentPaymentAddress string
+	Amount               int64 // sats, 0 if not set
+	Label                string
+	Message              string
+}
+
+// IsPaymentURI checks whether s starts with the bitcoin: scheme
+func IsPaymentURI(s string) bool {
+	return len(s) >= len(paymentURIScheme) && strings.EqualFold(s[:len(paymentURIScheme)], paymentURIScheme)
+}
+
+// ParsePaymentURI
+// parses a BIP 21 URI. Unknown parameters prefixed with `req-` are rejected as required by BIP 21.
+// The addresses are not checked against a network, see PaymentURI.Recipient.
+func ParsePaymentURI(uri string) (*PaymentURI, error) {
+	if !IsPaymentURI(uri) {
+		return nil, fmt.Errorf("%w: missing %s scheme", ErrInvalidPaymentURI, paymentURIScheme)
+	}
+	rest := uri[len(paymentURIScheme):]
+
+	path, rawQuery, _ := strings.Cut(rest, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPaymentURI, err)
+	}
+
+	paymentURI := &PaymentURI{Address: normaliseURIAddress(path)}
+	for key, values := range query {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("%w: parameter %s is set %d times", ErrInvalidPaymentURI, key, len(values))
+		}
+		value := values[0]
+
+		switch strings.ToLower(key) {
+		case "amount":
+			paymentURI.Amount, err = parseBTCAmount(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidPaymentURI, err)
+			}
+		case "label":
+			paymentURI.Label = value
+		case "message":
+			paymentURI.Message = value
+		case "sp":
+			paymentURI.SilentPaymentAddress = normaliseURIAddress(value)
+		default:
+			if strings.HasPrefix(strings.ToLower(key), "req-") {
+				return nil, fmt.Errorf("%w: unsupported required parameter %s", ErrInvalidPaymentURI, key)
+			}
+		}
+	}
+
+	if paymentURI.Address == "" && paymentURI.SilentPaymentAddress == "" {
+		return nil, fmt.Errorf("%w: no address", ErrInvalidPaymentURI)
+	}
+
+	return paymentURI, nil
+}
+
+// Recipient
+// returns the recipient for the URI. The SP address is preferred over the fallback address.
+// All addresses of the URI have to be valid for chainParams.
+func (u *PaymentURI) Recipient(chainParams *chaincfg.Params) (*Recipient, error) {
+	for _, address := range []string{u.SilentPaymentAddress, u.Address} {
+		if address == "" {
+			continue
+		}
+		_, err := recipientPkScript(address, chainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recipient := &Recipient{
+		Address: u.Address,
+		Amount:  u.Amount,
+	}
+	if u.SilentPaymentAddress != "" {
+		recipient.Address = u.SilentPaymentAddress
+	}
+
+	var annotation []string
+	for _, text := range []string{u.Label, u.Message} {
+		if text != "" {
+			annotation = append(annotation, text)
+		}
+	}
+	recipient.Annotation = strings.Join(annotation, ": ")
+
+	return recipient, nil
+}
+
+// ResolvePaymentURIs
+// replaces recipients given as BIP 21 URI with the recipient of the URI.
+// The amount of the URI is used if the recipient has none, the annotation is only taken if the recipient has none.
+// Returns RecipientErrors for all URIs which can't be used.
+func ResolvePaymentURIs(recipients []*Recipient, chainParams *chaincfg.Params) error {
+	var recipientErrors RecipientErrors
+	for i, recipient := range recipients {
+		if !IsPaymentURI(recipient.Address) {
+			continue
+		}
+
+		resolved, err := resolvePaymentURI(recipient, chainParams)
+		if err != nil {
+			recipientErrors = append(recipientErrors, &RecipientError{Row: i + 1, Address: recipient.Address, Err: err})
+			continue
+		}
+		recipients[i] = resolved
+	}
+
+	if len(recipientErrors) > 0 {
+		return recipientErrors
+	}
+	return nil
+}
+
+func resolvePaymentURI(recipient *Recipient, chainParams *chaincfg.Params) (*Recipient, error) {
+	paymentURI, err := ParsePaymentURI(recipient.Address)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := paymentURI.Recipient(chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if recipient.Amount > 0 {
+		if resolved.Amount > 0 && resolved.Amount != recipient.Amount {
+			return nil, fmt.Errorf("%w: amount %d does not match the URI amount %d", ErrInvalidPaymentURI, recipient.Amount, resolved.Amount)
+		}
+		resolved.Amount = recipient.Amount
+	}
+	if recipient.Annotation != "" {
+		resolved.Annotation = recipient.Annotation
+	}
+	resolved.Data = recipient.Data
+
+	return resolved, nil
+}
+
+// normaliseURIAddress lowercases all uppercase bech32 addresses which are used in QR codes
+func normaliseURIAddress(address string) string {
+	if address == strings.ToUpper(address) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// parseBTCAmount parses a decimal BTC amount without floating point errors and returns sats
+func parseBTCAmount(amount string) (int64, error) {
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if whole == "" && fraction == "" || len(fraction) > 8 || strings.ContainsAny(amount, "+-") {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	fraction += strings.Repeat("0", 8-len(fraction))
+
+	btc, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || btc > 21_000_000 {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	sats, err := strconv.ParseInt(fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	return btc*100_000_000 + sats, nil
+}
@@ -0,0 +1,120 @@
+package src
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestParsePaymentURI(t *testing.T) {
+	spAddress := "sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc9pkqwv"
+	fallback := "bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu"
+
+	testCases := []struct {
+		name   string
+		uri    string
+		target *Recipient
+		err    error
+	}{
+		{
+			name:   "sp preferred",
+			uri:    "bitcoin:" + fallback + "?sp=" + spAddress + "&amount=0.0015&label=Shop&message=Order%2042",
+			target: &Recipient{Address: spAddress, Amount: 150_000, Annotation: "Shop: Order 42"},
+		},
+		{
+			name:   "fallback only",
+			uri:    "BITCOIN:" + "BC1QCR8TE4KR609GCAWUTMRZA0J4XV80JY8Z306FYU" + "?amount=1",
+			target: &Recipient{Address: fallback, Amount: 100_000_000},
+		},
+		{
+			name:   "sp only",
+			uri:    "bitcoin:?sp=" + spAddress,
+			target: &Recipient{Address: spAddress},
+		},
+		{
+			name: "required parameter",
+			uri:  "bitcoin:" + fallback + "?req-somethingnew=1",
+			err:  ErrInvalidPaymentURI,
+		},
+		{
+			name: "invalid amount",
+			uri:  "bitcoin:" + fallback + "?amount=0.000000001",
+			err:  ErrInvalidPaymentURI,
+		},
+		{
+			name: "no address",
+			uri:  "bitcoin:?amount=1",
+			err:  ErrInvalidPaymentURI,
+		},
+		{
+			name: "wrong network",
+			uri:  "bitcoin:tb1pqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqkgkkf5?sp=" + spAddress,
+			err:  ErrAddressNetworkMismatch,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			paymentURI, err := ParsePaymentURI(testCase.uri)
+			var recipient *Recipient
+			if err == nil {
+				recipient, err = paymentURI.Recipient(&chaincfg.MainNetParams)
+			}
+			if testCase.target == nil {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				if testCase.err != nil && !errors.Is(err, testCase.err) {
+					t.Errorf("expected %v, got %v", testCase.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if recipient.Address != testCase.target.Address || recipient.Amount != testCase.target.Amount || recipient.Annotation != testCase.target.Annotation {
+				t.Errorf("expected %+v, got %+v", testCase.target, recipient)
+			}
+		})
+	}
+}
+
+func TestResolvePaymentURIs(t *testing.T) {
+	spAddress := "sp1qqgste7k9hx0qftg6qmwlkqtwuy6cycyavzmzj85c6qdfhjdpdjtdgqjuexzk6murw56suy3e0rd2cgqvycxttddwsvgxe2usfpxumr70xc9pkqwv"
+	recipients := []*Recipient{
+		{Address: "bitcoin:?sp=" + spAddress + "&amount=0.0001&label=Donation"},
+		{Address: "bitcoin:?sp=" + spAddress, Amount: 5_000, Annotation: "own note"},
+		{Address: "bitcoin:?sp=" + spAddress + "&amount=0.0001", Amount: 5_000},
+		{Address: "bitcoin:?sp=" + spAddress},
+	}
+
+	err := ResolvePaymentURIs(recipients, &chaincfg.TestNet3Params)
+	var recipientErrors RecipientErrors
+	if !errors.As(err, &recipientErrors) {
+		t.Errorf("expected RecipientErrors, got %v", err)
+		return
+	}
+	// the SP address is for mainnet
+	if len(recipientErrors) != 4 || !errors.Is(recipientErrors[0], ErrAddressNetworkMismatch) {
+		t.Errorf("expected all URIs to be rejected for testnet, got %v", err)
+		return
+	}
+
+	err = ResolvePaymentURIs(recipients, &chaincfg.MainNetParams)
+	if !errors.As(err, &recipientErrors) || len(recipientErrors) != 1 || recipientErrors[0].Row != 3 {
+		t.Errorf("expected the amount mismatch in row 3, got %v", err)
+		return
+	}
+	if recipients[0].Address != spAddress || recipients[0].Amount != 10_000 || recipients[0].Annotation != "Donation" {
+		t.Errorf("wrong recipient for row 1: %+v", recipients[0])
+	}
+	if recipients[1].Amount != 5_000 || recipients[1].Annotation != "own note" {
+		t.Errorf("wrong recipient for row 2: %+v", recipients[1])
+	}
+	// the missing amount is reported by ValidateRecipients
+	if recipients[3].Amount != 0 {
+		t.Errorf("wrong recipient for row 4: %+v", recipients[3])
+	}
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/go-bip352"
+)
+
+// ComputeTweak
+// computes the tweak (input_hash * A_sum) of a transaction the same way an indexer does it.
+// prevOuts has to contain the spent output for every input of tx.
+// Returns bip352.ErrNoEligibleVins if the transaction has no inputs eligible for the shared secret derivation.
+func ComputeTweak(tx *wire.MsgTx, prevOuts map[wire.OutPoint]*wire.TxOut) ([33]byte, error) {
+	var vins = make([]*bip352.Vin, len(tx.TxIn))
+	for i, txIn := range tx.TxIn {
+		prevOut, ok := prevOuts[txIn.PreviousOutPoint]
+		if !ok {
+			return [33]byte{}, fmt.Errorf("prevout for input %s is missing", txIn.PreviousOutPoint)
+		}
+		vins[i] = &bip352.Vin{
+			Txid:         bip352.ConvertToFixedLength32(bip352.ReverseBytesCopy(txIn.PreviousOutPoint.Hash[:])),
+			Vout:         txIn.PreviousOutPoint.Index,
+			Amount:       uint64(prevOut.Value),
+			Witness:      txIn.Witness,
+			ScriptPubKey: prevOut.PkScript,
+			ScriptSig:    txIn.SignatureScript,
+		}
+	}
+
+	var pubKeys [][33]byte
+	for _, vin := range vins {
+		pubKey, utxoType := bip352.ExtractPubKey(vin)
+		switch utxoType {
+		case bip352.Unknown:
+			continue
+		case bip352.P2TR:
+			// x-only keys are always even
+			pubKeys = append(pubKeys, bip352.ConvertToFixedLength33(append([]byte{0x02}, pubKey...)))
+		default:
+			if len(pubKey) != 33 {
+				continue
+			}
+			pubKeys = append(pubKeys, bip352.ConvertToFixedLength33(pubKey))
+		}
+	}
+
+	if len(pubKeys) == 0 {
+		return [33]byte{}, bip352.ErrNoEligibleVins
+	}
+
+	publicKeySum, err := bip352.SumPublicKeys(pubKeys)
+	if err != nil {
+		return [33]byte{}, err
+	}
+
+	// the smallest outpoint is taken from all inputs not only the eligible ones
+	inputHash, err := bip352.ComputeInputHash(vins, publicKeySum)
+	if err != nil {
+		return [33]byte{}, err
+	}
+
+	// input_hash * A_sum
+	return bip352.CreateSharedSecret(publicKeySum, inputHash, nil)
+}
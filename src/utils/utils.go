@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/setavenger/blindbitd/src/logging"
 	"github.com/setavenger/go-bip352"
 )
@@ -42,8 +43,13 @@ func CopyBytes(bytes []byte) []byte {
 // ConvertPubKeyToScriptHash
 // Converts the given taproot pubKey to a scriptHash which can be checked with electrumX
 func ConvertPubKeyToScriptHash(pubKey [32]byte) string {
-	data := append([]byte{0x51, 0x20}, pubKey[:]...)
-	hash := sha256.Sum256(data)
+	return ConvertPkScriptToScriptHash(append([]byte{0x51, 0x20}, pubKey[:]...))
+}
+
+// ConvertPkScriptToScriptHash
+// Converts any scriptPubKey to a scriptHash which can be checked with electrumX
+func ConvertPkScriptToScriptHash(pkScript []byte) string {
+	hash := sha256.Sum256(pkScript)
 	return hex.EncodeToString(bip352.ReverseBytesCopy(hash[:]))
 }
 
@@ -60,3 +66,17 @@ func SerialiseVinToOutpoint(vin bip352.Vin) ([36]byte, error) {
 	copy(outpoint[:], buf.Bytes())
 	return outpoint, nil
 }
+
+// SerialiseWireOutpoint serialises a wire.OutPoint in the same LE encoding as SerialiseVinToOutpoint
+func SerialiseWireOutpoint(outpoint wire.OutPoint) ([36]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(outpoint.Hash[:])
+	err := binary.Write(&buf, binary.LittleEndian, outpoint.Index)
+	if err != nil {
+		logging.ErrorLogger.Println(err)
+		return [36]byte{}, err
+	}
+	var result [36]byte
+	copy(result[:], buf.Bytes())
+	return result, nil
+}
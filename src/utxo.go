@@ -10,15 +10,44 @@ import (
 	"github.com/setavenger/go-bip352"
 )
 
+// ScriptType
+// defines how an OwnedUTXO is locked and therefore how it is signed.
+// The zero value is a silent payment output, so UTXOs stored before script types existed stay valid.
+type ScriptType int8
+
+const (
+	ScriptTypeSilentPayment ScriptType = iota
+	ScriptTypeP2TR                     // BIP 86 key-path output of the regular taproot account
+	ScriptTypeP2WPKH                   // BIP 84 output of the regular segwit account
+)
+
+// DerivationPath
+// the last two levels (change/index) of the account given by the ScriptType
+type DerivationPath struct {
+	Change uint32 `json:"change"`
+	Index  uint32 `json:"index"`
+}
+
 type OwnedUTXO struct {
-	Txid         [32]byte      `json:"txid,omitempty"`
-	Vout         uint32        `json:"vout,omitempty"`
-	Amount       uint64        `json:"amount"`
-	PrivKeyTweak [32]byte      `json:"priv_key_tweak,omitempty"`
-	PubKey       [32]byte      `json:"pub_key,omitempty"`
-	Timestamp    uint64        `json:"timestamp,omitempty"`
-	State        UTXOState     `json:"utxo_state,omitempty"`
-	Label        *bip352.Label `json:"label"` // the pubKey associated with the label
+	Txid           [32]byte        `json:"txid,omitempty"`
+	Vout           uint32          `json:"vout,omitempty"`
+	Amount         uint64          `json:"amount"`
+	PrivKeyTweak   [32]byte        `json:"priv_key_tweak,omitempty"`
+	PubKey         [32]byte        `json:"pub_key,omitempty"` // x-only output key, empty for P2WPKH
+	Timestamp      uint64          `json:"timestamp,omitempty"`
+	State          UTXOState       `json:"utxo_state,omitempty"`
+	Label          *bip352.Label   `json:"label"` // the pubKey associated with the label
+	ScriptType     ScriptType      `json:"script_type,omitempty"`
+	PkScript       []byte          `json:"pk_script,omitempty"`       // only set for non SP outputs
+	DerivationPath *DerivationPath `json:"derivation_path,omitempty"` // only set for non SP outputs
+}
+
+// ScriptPubKey returns the locking script of the UTXO
+func (u *OwnedUTXO) ScriptPubKey() []byte {
+	if u.PkScript != nil {
+		return u.PkScript
+	}
+	return append([]byte{0x51, 0x20}, u.PubKey[:]...)
 }
 
 func (u *OwnedUTXO) SerialiseToOutpoint() ([36]byte, error) {
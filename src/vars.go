@@ -10,10 +10,42 @@ var (
 
 	/* [Network] */
 
-	// BlindBitServerAddress Indexing server for silent payments that follows the blindbit standard
+	// BlindBitServerAddress Indexing server for silent payments that follows the blindbit standard.
+	// The first of BlindBitServerAddresses if several are configured
 	BlindBitServerAddress string
-	// ElectrumServerAddress Electrum server
-	ElectrumServerAddress string
+	// BlindBitServerAddresses indexing servers in order of priority, the daemon fails over to the next one
+	BlindBitServerAddresses []string
+	// IndexerMaxLag an indexer is only used while it is at most this many blocks behind the other indexers
+	IndexerMaxLag uint64 = 2
+	// IndexerCrossCheck the tweak index and filters are compared between two indexers if true
+	IndexerCrossCheck bool
+	// IndexerProtocol how the indexing server is accessed, IndexerProtocolHTTP (JSON), IndexerProtocolBitcoind or IndexerProtocolP2P
+	IndexerProtocol = IndexerProtocolHTTP
+	// BlindBitTorProxyHost if set, requests to the indexing server go through tor. Empty by default
+	BlindBitTorProxyHost = ""
+	// BlindBitTorIsolation the indexer uses its own tor circuit per session, separate from other traffic through the same proxy
+	BlindBitTorIsolation = true
+	// TweakSource which tweaks are requested from the indexer, see UseCutThroughTweaks
+	TweakSource = TweakSourceAuto
+	// TweakFullIndexDepth in auto mode the most recent blocks up to this depth are scanned with the full tweak index
+	TweakFullIndexDepth uint64 = 1008
+	// UseIndexerCache tweaks, filters and UTXOs from the indexer are kept on disk for rescans
+	UseIndexerCache bool
+	// IndexerCacheMaxSize in bytes, the least recently used entries are pruned above this size
+	IndexerCacheMaxSize int64 = 1 << 30
+	// BitcoindRpcUrl the node used with IndexerProtocolBitcoind. Either user and password or the cookie file authenticate
+	BitcoindRpcUrl      string
+	BitcoindRpcUser     string
+	BitcoindRpcPassword string
+	BitcoindRpcCookie   string
+	// P2PPeerAddress the node used with IndexerProtocolP2P, it has to serve compact block filters
+	P2PPeerAddress string
+	// P2PStartHeight taproot outputs are tracked from this height on, has to be at or below the taproot activation
+	P2PStartHeight uint64
+	// ElectrumServers Electrum servers in order of preference, the daemon fails over to the next one
+	ElectrumServers []ElectrumServer
+	// ElectrumPingInterval the Electrum connection is pinged in this interval and replaced if it does not answer
+	ElectrumPingInterval time.Duration
 
 	/* [Wallet] */
 
@@ -35,4 +67,25 @@ var (
 
 	// AutomaticScanInterval has different values depending on whether Electrum is used or not
 	AutomaticScanInterval time.Duration = 5 * time.Minute // 5 minutes if electrum is active
+
+	// BroadcastBackends transactions are broadcast through the first of these backends which accepts them
+	BroadcastBackends []BroadcastBackend
+
+	// RebroadcastInterval pending outgoing transactions are broadcast again after this duration
+	RebroadcastInterval = 30 * time.Minute
+
+	// DropTimeout pending outgoing transactions are reported as dropped if they are not confirmed after this duration
+	DropTimeout = 72 * time.Hour
+
+	// UseIndexerFeeEstimates if true fee estimates are also requested from the indexing server (esplora style /fee-estimates)
+	UseIndexerFeeEstimates bool
+
+	// FeeEstimateCacheDuration fee estimates are cached for this duration before new estimates are requested
+	FeeEstimateCacheDuration = 5 * time.Minute
+
+	// AccountGapLimit number of addresses per chain which are searched in the regular (non SP) accounts
+	AccountGapLimit uint32 = 100
+
+	// SpendingPolicy is checked before any transaction is signed, nil if no policy file is configured
+	SpendingPolicy *Policy
 )
@@ -7,8 +7,11 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/setavenger/blindbitd/src/logging"
+	"github.com/setavenger/blindbitd/src/utils"
 	"github.com/setavenger/go-bip352"
 )
 
@@ -32,6 +35,12 @@ type Wallet struct {
 	// todo should LabelsMapping be integrated with Wallet.Labels
 	LabelsMapping LabelsMapping `json:"labels_mapping"` // never show LabelsMapping addresses to the user - it includes the change label which should NEVER be shown to normal users
 	UTXOMapping   UTXOMapping   `json:"utxo_mapping"`   // used to keep track of utxos and not add the same twice
+	// OutgoingTransactions all transactions created by this wallet
+	OutgoingTransactions []*OutgoingTransaction `json:"outgoing_transactions"`
+	// TaprootAddresses the generated receive addresses of the regular BIP 86 account, ordered by index
+	TaprootAddresses []*AccountAddress `json:"taproot_addresses,omitempty"`
+
+	accountKeys map[ScriptType]*hdkeychain.ExtendedKey // keys of the regular (non SP) accounts, loaded from Keys
 }
 
 func NewWallet(birthHeight uint64) *Wallet {
@@ -158,6 +167,8 @@ func (w *Wallet) AddUTXOs(utxos []*OwnedUTXO) error {
 		}
 		_, exists := w.UTXOMapping[key]
 		if exists {
+			// outputs of our own unconfirmed transactions are known before their block is scanned
+			w.confirmUTXO(utxo)
 			continue
 		}
 
@@ -177,9 +188,79 @@ func (w *Wallet) AddUTXOs(utxos []*OwnedUTXO) error {
 // Chose this approach to avoid accidentally exposing the change address.
 func (w *Wallet) FindLabelByPubKey(pubKey [33]byte) *Label {
 	panic("implement me")
+}
+
+func (w *Wallet) AddOutgoingTransaction(tx *OutgoingTransaction) {
+	w.OutgoingTransactions = append(w.OutgoingTransactions, tx)
+}
+
+// GetOutgoingTransaction returns nil if no transaction with that txid was created by this wallet
+func (w *Wallet) GetOutgoingTransaction(txid string) *OutgoingTransaction {
+	for _, tx := range w.OutgoingTransactions {
+		if tx.Txid == txid {
+			return tx
+		}
+	}
 	return nil
 }
 
+// FindUTXOByOutpoint
+// outpoint has to be LE encoded (see OwnedUTXO.SerialiseToOutpoint). Returns nil if the wallet does not own the outpoint
+func (w *Wallet) FindUTXOByOutpoint(outpoint [36]byte) (*OwnedUTXO, error) {
+	for _, utxo := range w.UTXOs {
+		utxoOutpoint, err := utxo.SerialiseToOutpoint()
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if utxoOutpoint == outpoint {
+			return utxo, nil
+		}
+	}
+	return nil, nil
+}
+
+// confirmUTXO sets a known unconfirmed UTXO to unspent if utxo is the confirmed version of it
+func (w *Wallet) confirmUTXO(utxo *OwnedUTXO) {
+	if utxo.State != StateUnspent {
+		return
+	}
+	for _, existing := range w.UTXOs {
+		if existing.Txid == utxo.Txid && existing.Vout == utxo.Vout && existing.State == StateUnconfirmed {
+			existing.State = StateUnspent
+			existing.Timestamp = utxo.Timestamp
+			return
+		}
+	}
+}
+
+// MarkTxInputsSpent
+// sets all UTXOs of the wallet which are spent by tx to spent_unconfirmed and returns them.
+// UTXOs which are already marked as spent keep their state.
+func (w *Wallet) MarkTxInputsSpent(tx *wire.MsgTx) (UtxoCollection, error) {
+	var spent UtxoCollection
+	for _, txIn := range tx.TxIn {
+		outpoint, err := utils.SerialiseWireOutpoint(txIn.PreviousOutPoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		utxo, err := w.FindUTXOByOutpoint(outpoint)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		if utxo == nil {
+			continue
+		}
+		if utxo.State != StateSpent {
+			utxo.State = StateUnconfirmedSpent
+		}
+		spent = append(spent, utxo)
+	}
+	return spent, nil
+}
+
 func (w *Wallet) SecretKeyScan() [32]byte {
 	return w.secretKeyScan
 }
@@ -297,7 +378,6 @@ type Address struct {
 func (w *Wallet) SortedAddresses() ([]Address, error) {
 	var addresses []Address
 
-	addresses = append(addresses)
 	var nextM = 1
 
 	for address, comment := range w.Addresses {
@@ -337,6 +417,42 @@ func (w *Wallet) SortedAddresses() ([]Address, error) {
 	return addresses, nil
 }
 
+// ConvertUTXOIntoSigningVin
+// creates a vin with the full secret key needed to sign the UTXO and to derive SP outputs.
+// SP outputs use the tweak and the spend key, regular outputs are derived from their account.
+func (w *Wallet) ConvertUTXOIntoSigningVin(utxo *OwnedUTXO) (*bip352.Vin, error) {
+	switch utxo.ScriptType {
+	case ScriptTypeSilentPayment:
+		vin := ConvertOwnedUTXOIntoVin(utxo)
+		fullVinSecretKey := bip352.AddPrivateKeys(*vin.SecretKey, w.SecretKeySpend())
+		vin.SecretKey = &fullVinSecretKey
+		return &vin, nil
+	case ScriptTypeP2TR, ScriptTypeP2WPKH:
+		privKey, err := w.DeriveAccountPrivKey(utxo.ScriptType, utxo.DerivationPath)
+		if err != nil {
+			logging.ErrorLogger.Println(err)
+			return nil, err
+		}
+		taproot := utxo.ScriptType == ScriptTypeP2TR
+		if taproot {
+			privKey = taprootOutputPrivKey(privKey)
+		}
+		secretKey := bip352.ConvertToFixedLength32(privKey.Serialize())
+		publicKey := bip352.ConvertToFixedLength33(privKey.PubKey().SerializeCompressed())
+		return &bip352.Vin{
+			Txid:         utxo.Txid,
+			Vout:         utxo.Vout,
+			Amount:       utxo.Amount,
+			ScriptPubKey: utxo.ScriptPubKey(),
+			SecretKey:    &secretKey,
+			PublicKey:    &publicKey,
+			Taproot:      taproot,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown script type %d", utxo.ScriptType)
+	}
+}
+
 func ConvertOwnedUTXOIntoVin(utxo *OwnedUTXO) bip352.Vin {
 	vin := bip352.Vin{
 		Txid:         utxo.Txid,
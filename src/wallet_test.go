@@ -0,0 +1,77 @@
+package src
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/setavenger/go-bip352"
+)
+
+func TestMarkTxInputsSpent(t *testing.T) {
+	wallet := NewWallet(0)
+	wallet.UTXOMapping = UTXOMapping{}
+
+	var txid [32]byte
+	txid[0] = 0xab
+	utxos := []*OwnedUTXO{
+		{Txid: txid, Vout: 0, Amount: 10_000, State: StateUnspent},
+		{Txid: txid, Vout: 1, Amount: 20_000, State: StateUnspent},
+		{Txid: txid, Vout: 2, Amount: 30_000, State: StateSpent},
+	}
+	err := wallet.AddUTXOs(utxos)
+	if err != nil {
+		t.Errorf("error adding utxos: %v", err)
+		return
+	}
+
+	// outpoints reference the txid in internal byte order
+	hash, err := chainhash.NewHash(bip352.ReverseBytesCopy(txid[:]))
+	if err != nil {
+		t.Errorf("error creating hash: %v", err)
+		return
+	}
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, 1), nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, 2), nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{0x01}, 0), nil, nil)) // foreign input
+
+	spent, err := wallet.MarkTxInputsSpent(tx)
+	if err != nil {
+		t.Errorf("error marking inputs: %v", err)
+		return
+	}
+	if len(spent) != 2 {
+		t.Errorf("expected 2 matched inputs, got %d", len(spent))
+	}
+	if utxos[0].State != StateUnspent || utxos[1].State != StateUnconfirmedSpent || utxos[2].State != StateSpent {
+		t.Errorf("wrong states: %d %d %d", utxos[0].State, utxos[1].State, utxos[2].State)
+	}
+}
+
+func TestAddUTXOsConfirmsUnconfirmed(t *testing.T) {
+	wallet := NewWallet(0)
+	wallet.UTXOMapping = UTXOMapping{}
+
+	var txid [32]byte
+	txid[0] = 0xcd
+	change := &OwnedUTXO{Txid: txid, Vout: 1, Amount: 5_000, State: StateUnconfirmed}
+	err := wallet.AddUTXOs(UtxoCollection{change})
+	if err != nil {
+		t.Errorf("error adding utxo: %v", err)
+		return
+	}
+
+	// the block scan finds the same output once it is confirmed
+	err = wallet.AddUTXOs(UtxoCollection{{Txid: txid, Vout: 1, Amount: 5_000, State: StateUnspent, Timestamp: 1_700_000_000}})
+	if err != nil {
+		t.Errorf("error adding utxo: %v", err)
+		return
+	}
+	if len(wallet.UTXOs) != 1 {
+		t.Errorf("utxo was added twice")
+	}
+	if change.State != StateUnspent || change.Timestamp != 1_700_000_000 {
+		t.Errorf("utxo was not confirmed: state %d timestamp %d", change.State, change.Timestamp)
+	}
+}